@@ -34,11 +34,17 @@ func SkipIfNotAcc(t *testing.T) {
 //  * PINGDOM_PASSWORD
 //  * PINGDOM_APP_KEY
 //
+// PINGDOM_API_TOKEN can be set instead of all three, to run the acceptance
+// tests against version 3.1 of the API.
+//
 // Acceptance tests cannot continue if these are not set so there is no point
 // in continuing.
 func PanicIfMissingEnv() {
+	if os.Getenv("PINGDOM_API_TOKEN") != "" {
+		return
+	}
 	if os.Getenv("PINGDOM_EMAIL_ADDRESS") == "" || os.Getenv("PINGDOM_PASSWORD") == "" || os.Getenv("PINGDOM_APP_KEY") == "" {
-		panic("Please ensure the environment variables PINGDOM_EMAIL_ADDRESS, PINGDOM_PASSWORD, and PINGDOM_APP_KEY are set for acceptance tests")
+		panic("Please ensure either PINGDOM_API_TOKEN, or the environment variables PINGDOM_EMAIL_ADDRESS, PINGDOM_PASSWORD, and PINGDOM_APP_KEY are set for acceptance tests")
 	}
 }
 
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"strings"
+
 	"github.com/imdario/mergo"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom/request"
@@ -31,25 +33,76 @@ type Client struct {
 
 // New handles logic for either setting a conneciton based on supplied
 // configuration, or getting the configuration from a specific provider.
+//
+// If the supplied configuration sets an email address, password, or
+// application key but no API token, any API token from the default
+// configuration is dropped, so that explicit credentials always take
+// precedence over the environment.
 func New(configs ...pingdom.Config) *Client {
 	c := &Client{
 		Config: pingdom.DefaultConfigProvider(),
 	}
+	var explicitToken, explicitCredentials bool
 	for _, v := range configs {
 		mergo.MergeWithOverwrite(&c.Config, v)
+		if v.APIToken != "" {
+			explicitToken = true
+		}
+		if v.EmailAddress != "" || v.Password != "" || v.AppKey != "" {
+			explicitCredentials = true
+		}
+	}
+	if explicitCredentials && !explicitToken {
+		c.Config.APIToken = ""
 	}
 	return c
 }
 
+// legacyAPIPrefix is the URI prefix for version 2.0 of the API.
+const legacyAPIPrefix = "/api/2.0/"
+
+// tokenAPIPrefix is the URI prefix for version 3.1 of the API, which is the
+// only version that accepts API tokens.
+const tokenAPIPrefix = "/api/3.1/"
+
+// resolveURI returns the URI a request should be sent to. If an API token is
+// configured, version 2.0 URIs are rewritten to their version 3.1
+// equivalents, as version 2.0 of the API does not accept token
+// authentication. Services whose endpoints have no version 3.1 equivalent
+// return an error before a request is sent, so they never get here.
+func (c *Client) resolveURI(uri string) string {
+	if c.Config.APIToken != "" && strings.HasPrefix(uri, legacyAPIPrefix) {
+		return tokenAPIPrefix + strings.TrimPrefix(uri, legacyAPIPrefix)
+	}
+	return uri
+}
+
 // SendRequest sends a request to a request.Request object.
 // It's expected that references to specific data types are passed - no
 // checking is done to make sure that references are passed.
 func (c *Client) SendRequest(method, uri string, in, out interface{}) error {
 	r := request.NewRequest(c.Config)
 	r.Method = method
-	r.URI = uri
+	r.URI = c.resolveURI(uri)
+	r.Input = in
+	r.Output = out
+	err := r.Send()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SendJSONRequest works like SendRequest, but sends the input of POST and PUT
+// requests as a JSON body. This is required by the version 3.1 endpoints of
+// the API.
+func (c *Client) SendJSONRequest(method, uri string, in, out interface{}) error {
+	r := request.NewRequest(c.Config)
+	r.Method = method
+	r.URI = c.resolveURI(uri)
 	r.Input = in
 	r.Output = out
+	r.JSON = true
 	err := r.Send()
 	if err != nil {
 		return err
@@ -17,6 +17,7 @@ package client
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"reflect"
 	"testing"
 
@@ -101,6 +102,17 @@ func httpOKTestServer() *httptest.Server {
 	})
 }
 
+func httpTokenOKTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.1/checks" || r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, okResponseText, http.StatusOK)
+	})
+}
+
 func TestClientNew(t *testing.T) {
 	c := New(pingdomConfig())
 
@@ -118,6 +130,28 @@ func TestClientNew(t *testing.T) {
 	}
 }
 
+func TestClientNewCredentialsOverrideEnvToken(t *testing.T) {
+	os.Setenv("PINGDOM_API_TOKEN", "abcdefgh0123456789token")
+	defer os.Unsetenv("PINGDOM_API_TOKEN")
+
+	c := New(pingdomConfig())
+	if c.Config.APIToken != "" {
+		t.Fatalf("Expected APIToken to be empty with explicit credentials, got %s", c.Config.APIToken)
+	}
+
+	c = New()
+	if c.Config.APIToken != "abcdefgh0123456789token" {
+		t.Fatalf("Expected APIToken to be abcdefgh0123456789token, got %s", c.Config.APIToken)
+	}
+
+	cfg := pingdomConfig()
+	cfg.APIToken = "explicit0123456789token"
+	c = New(cfg)
+	if c.Config.APIToken != "explicit0123456789token" {
+		t.Fatalf("Expected APIToken to be explicit0123456789token, got %s", c.Config.APIToken)
+	}
+}
+
 func TestClientSendRequestSuccess(t *testing.T) {
 	ts := httpOKTestServer()
 	defer ts.Close()
@@ -139,6 +173,64 @@ func TestClientSendRequestSuccess(t *testing.T) {
 	}
 }
 
+func TestClientSendJSONRequestSuccess(t *testing.T) {
+	ts := httpOKTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := queryStringDataTestBasic()
+	out := okResponseType{}
+	err := c.SendJSONRequest("POST", "/api/3.1/test", &in, &out)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := okResponse()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestClientSendRequestToken(t *testing.T) {
+	ts := httpTokenOKTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := queryStringDataTestBasic()
+	out := okResponseType{}
+	err := c.SendRequest("GET", "/api/2.0/checks", &in, &out)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := okResponse()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestClientResolveURI(t *testing.T) {
+	c := New(pingdomConfig())
+	if out := c.resolveURI("/api/2.0/checks"); out != "/api/2.0/checks" {
+		t.Fatalf("Expected /api/2.0/checks without a token, got %s", out)
+	}
+
+	c.Config.APIToken = "abcdefgh0123456789token"
+	if out := c.resolveURI("/api/2.0/checks/85975"); out != "/api/3.1/checks/85975" {
+		t.Fatalf("Expected /api/3.1/checks/85975 with a token, got %s", out)
+	}
+	if out := c.resolveURI("/api/3.1/tms/check"); out != "/api/3.1/tms/check" {
+		t.Fatalf("Expected /api/3.1/tms/check to be unchanged, got %s", out)
+	}
+}
+
 func TestClientSendRequestError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
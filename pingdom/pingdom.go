@@ -39,8 +39,27 @@ const apiAddress = "https://api.pingdom.com"
 //   }
 //   svc := checks.New(cfg)
 //
-// Note that default options are set for EmailAddress, Password, and AppKey.
-// See the DefaultConfigProvider method for more details.
+// Note that default options are set for EmailAddress, Password, AppKey, and
+// APIToken. See the DefaultConfigProvider method for more details.
+//
+//
+// API Tokens
+//
+// Version 3.1 of the API authenticates with an API token instead of an email
+// address, password, and application key:
+//
+//   cfg := pingdom.Config{
+//     APIToken: "token",
+//   }
+//   svc := checks.New(cfg)
+//
+// When APIToken is set, it is sent as a bearer token, and requests for
+// version 2.0 endpoints are sent to their version 3.1 equivalents instead.
+// Services for endpoints that have no version 3.1 equivalent return an error
+// without contacting the API.
+//
+// A token from PINGDOM_API_TOKEN is ignored if the configuration passed to a
+// service sets EmailAddress, Password, or AppKey without setting APIToken.
 type Config struct {
 	// The email address for the Pingdom account.
 	EmailAddress string
@@ -51,6 +70,10 @@ type Config struct {
 	// The application key required for API requests.
 	AppKey string
 
+	// The API token for version 3.1 of the API. If set, EmailAddress,
+	// Password, and AppKey are not used.
+	APIToken string
+
 	// The API endpoint. Changing this is only recommended for testing.
 	Endpoint string
 }
@@ -60,6 +83,7 @@ type Config struct {
 //  * EmailAddress defaults to PINGDOM_EMAIL_ADDRESS, if set, otherwise empty
 //  * Password defaults to PINGDOM_PASSWORD, if set, otherwise empty
 //  * AppKey defaults to PINGDOM_APP_KEY, if set, otherwise empty
+//  * APIToken defaults to PINGDOM_API_TOKEN, if set, otherwise empty
 //
 // This essentially loads an initial config state for any given
 // API service.
@@ -78,6 +102,8 @@ func DefaultConfigProvider() Config {
 			cfg.Password = d[1]
 		case "PINGDOM_APP_KEY":
 			cfg.AppKey = d[1]
+		case "PINGDOM_API_TOKEN":
+			cfg.APIToken = d[1]
 		}
 	}
 	return cfg
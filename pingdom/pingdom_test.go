@@ -22,12 +22,14 @@ func setPingdomenv() {
 	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
 	os.Setenv("PINGDOM_PASSWORD", "changeit")
 	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func unsetPingdomenv() {
 	os.Unsetenv("PINGDOM_EMAIL_ADDRESS")
 	os.Unsetenv("PINGDOM_PASSWORD")
 	os.Unsetenv("PINGDOM_APP_KEY")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func TestPingdomDefaultConfigProviderWithEnv(t *testing.T) {
@@ -62,4 +64,17 @@ func TestPingdomDefaultConfigProviderNoEnv(t *testing.T) {
 	if c.AppKey != "" {
 		t.Fatalf("Expected AppKey to be empty, got %s", c.AppKey)
 	}
+	if c.APIToken != "" {
+		t.Fatalf("Expected APIToken to be empty, got %s", c.APIToken)
+	}
+}
+
+func TestPingdomDefaultConfigProviderWithTokenEnv(t *testing.T) {
+	unsetPingdomenv()
+	os.Setenv("PINGDOM_API_TOKEN", "abcdefgh0123456789token")
+	defer os.Unsetenv("PINGDOM_API_TOKEN")
+	c := DefaultConfigProvider()
+	if c.APIToken != "abcdefgh0123456789token" {
+		t.Fatalf("Expected APIToken to be abcdefgh0123456789token, got %s", c.APIToken)
+	}
 }
@@ -59,6 +59,10 @@ type Request struct {
 	// The request data.
 	Input interface{}
 
+	// Send the request data as a JSON body instead of a form-encoded one.
+	// GET requests always send their data as a query string.
+	JSON bool
+
 	// The output of the request.
 	Output interface{}
 }
@@ -120,21 +124,42 @@ func dataToQueryString(d interface{}) string {
 	return v.Encode()
 }
 
+// dataToJSON takes an interface{} and converts it to a JSON document,
+// suitable for use as the body of Pingdom 3.1 POST/PUT requests. A nil
+// interface{} yields an empty body. Returns runtime panic if for some reason
+// d cannot be marshaled.
+func dataToJSON(d interface{}) []byte {
+	if d == nil {
+		return nil
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 // Send sends a request to the API endpoint, and parsees the response.
 func (r *Request) Send() error {
-	qs := dataToQueryString(r.Input)
 	var req *http.Request
 	var err error
 	client := &http.Client{}
 
 	switch r.Method {
 	case "GET":
+		qs := dataToQueryString(r.Input)
 		req, err = http.NewRequest(r.Method, fmt.Sprintf("%s%s?%s", r.Config.Endpoint, r.URI, qs), nil)
 	case "POST", "PUT", "DELETE":
 		var buf bytes.Buffer
-		buf.WriteString(fmt.Sprintf("%s", qs))
+		contentType := "application/x-www-form-urlencoded"
+		if r.JSON {
+			buf.Write(dataToJSON(r.Input))
+			contentType = "application/json"
+		} else {
+			buf.WriteString(dataToQueryString(r.Input))
+		}
 		req, err = http.NewRequest(r.Method, fmt.Sprintf("%s%s", r.Config.Endpoint, r.URI), &buf)
-		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Add("Content-Type", contentType)
 	default:
 		return fmt.Errorf("API request method %s not supported by Pingdom", r.Method)
 	}
@@ -143,8 +168,12 @@ func (r *Request) Send() error {
 		panic(err)
 	}
 
-	req.Header.Add("App-Key", r.Config.AppKey)
-	req.SetBasicAuth(r.Config.EmailAddress, r.Config.Password)
+	if r.Config.APIToken != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.Config.APIToken))
+	} else {
+		req.Header.Add("App-Key", r.Config.AppKey)
+		req.SetBasicAuth(r.Config.EmailAddress, r.Config.Password)
+	}
 
 	re, err := client.Do(req)
 
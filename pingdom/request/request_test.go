@@ -16,6 +16,7 @@ package request
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -86,6 +87,11 @@ type queryStringDataTestSemicolonArrayType struct {
 	AdditionalURLs []string `url:"additionalurls,semicolon"`
 }
 
+type jsonDataTestBasicType struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 func queryStringDataTestBasic() queryStringDataTestBasicType {
 	return queryStringDataTestBasicType{
 		ID:   1234,
@@ -133,6 +139,39 @@ func httpOKTestServer() *httptest.Server {
 	})
 }
 
+func jsonDataTestBasic() jsonDataTestBasicType {
+	return jsonDataTestBasicType{
+		ID:   1234,
+		Name: "My new HTTP check",
+	}
+}
+
+const jsonDataTestBasicText = `{"id":1234,"name":"My new HTTP check"}`
+
+func httpJSONOKTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get("Content-Type") != "application/json" || string(body) != jsonDataTestBasicText {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, okResponseText, http.StatusOK)
+	})
+}
+
+func httpTokenOKTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		_, _, basic := r.BasicAuth()
+		if r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" || r.Header.Get("App-Key") != "" || basic {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, okResponseText, http.StatusOK)
+	})
+}
+
 func pingdomConfig() pingdom.Config {
 	return pingdom.Config{
 		EmailAddress: "nobody@example.com",
@@ -198,6 +237,24 @@ func TestDataToQueryStringSemicolonArray(t *testing.T) {
 	}
 }
 
+func TestDataToJSON(t *testing.T) {
+	in := jsonDataTestBasic()
+	out := string(dataToJSON(in))
+	expected := jsonDataTestBasicText
+
+	if out != expected {
+		t.Fatalf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestDataToJSONNil(t *testing.T) {
+	out := dataToJSON(nil)
+
+	if out != nil {
+		t.Fatalf("expected nil, got %s", out)
+	}
+}
+
 func TestRequestSendSuccess(t *testing.T) {
 	ts := httpOKTestServer()
 	defer ts.Close()
@@ -240,6 +297,50 @@ func TestRequestSendSuccessGet(t *testing.T) {
 	}
 }
 
+func TestRequestSendSuccessJSON(t *testing.T) {
+	ts := httpJSONOKTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	in := jsonDataTestBasic()
+	out := okResponseType{}
+	r := testRequest(cfg, &in, &out)
+	r.JSON = true
+	err := r.Send()
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := okResponse()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestRequestSendSuccessToken(t *testing.T) {
+	ts := httpTokenOKTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	in := queryStringDataTestBasic()
+	out := okResponseType{}
+	r := testRequestGet(cfg, &in, &out)
+	err := r.Send()
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := okResponse()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
 func TestRequestSendError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
@@ -0,0 +1,128 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions contains the methods necessary for retrieving the actions
+// (alerts) that Pingdom has sent.
+package actions
+
+import (
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Action is the base client for action-related methods.
+type Action struct {
+	client.Client
+}
+
+// New returns a new instance of the Action API.
+func New(configs ...pingdom.Config) *Action {
+	c := &Action{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// AlertEntry holds a single alert from GetActionListOutput.
+type AlertEntry struct {
+	_ struct{}
+
+	// The name of the contact that the alert was sent to.
+	ContactName string
+
+	// The ID of the contact that the alert was sent to.
+	ContactID int
+
+	// The ID of the check that triggered the alert.
+	CheckID int
+
+	// The time the alert was sent (UNIX timestamp).
+	Time int
+
+	// The method the alert was sent through. One of email, sms, twitter,
+	// iphone, or android.
+	Via string
+
+	// The delivery status of the alert. One of sent, delivered, error,
+	// not_delivered, or no_credits.
+	Status string
+
+	// A short description of the alert.
+	MessageShort string
+
+	// The full message of the alert.
+	MessageFull string
+
+	// The target address, phone number, etc that the alert was sent to.
+	SentTo string
+
+	// true if the alert was charged (SMS only).
+	Charged bool
+}
+
+// ActionsEntry contains the actions returned by GetActionList.
+type ActionsEntry struct {
+	_ struct{}
+
+	// The list of matched alerts.
+	Alerts []AlertEntry
+}
+
+// GetActionListInput contains the input to send to the GetActionList
+// function.
+type GetActionListInput struct {
+	_ struct{}
+
+	// Only return actions sent after this time (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// Only return actions sent before this time (UNIX timestamp).
+	To int `url:"to,omitempty"`
+
+	// Limits the number of returned actions to the specified quantity.
+	// Max value is 300.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset for the action listing.
+	Offset int `url:"offset,omitempty"`
+
+	// Only return actions triggered by these checks.
+	CheckIDs []int `url:"checkids,comma,omitempty"`
+
+	// Only return actions sent to these contacts.
+	ContactIDs []int `url:"contactids,comma,omitempty"`
+
+	// Only return actions with these statuses. Each entry can be one of
+	// sent, delivered, error, not_delivered, or no_credits.
+	Status []string `url:"status,comma,omitempty"`
+
+	// Only return actions sent through these methods. Each entry can be one
+	// of email, sms, twitter, iphone, or android.
+	Via []string `url:"via,comma,omitempty"`
+}
+
+// GetActionListOutput contains the output for the GetActionList function.
+type GetActionListOutput struct {
+	_ struct{}
+
+	// The matched actions.
+	Actions ActionsEntry
+}
+
+// GetActionList gets a list of actions (alerts) that have been sent, based on
+// a specific set of filters.
+func (c *Action) GetActionList(in GetActionListInput) (out GetActionListOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/actions", &in, &out)
+	return
+}
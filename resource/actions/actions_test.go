@@ -0,0 +1,231 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getActionListInputData() GetActionListInput {
+	return GetActionListInput{
+		From:       1294235000,
+		To:         1294239000,
+		Limit:      100,
+		CheckIDs:   []int{85975},
+		ContactIDs: []int{111250, 111251},
+		Status:     []string{"sent", "delivered"},
+		Via:        []string{"email", "sms"},
+	}
+}
+
+const getActionListInputText = "checkids=85975&contactids=111250%2C111251&from=1294235000&limit=100&status=sent%2Cdelivered&to=1294239000&via=email%2Csms"
+
+func getActionListOutputData() GetActionListOutput {
+	return GetActionListOutput{
+		Actions: ActionsEntry{
+			Alerts: []AlertEntry{
+				AlertEntry{
+					ContactName:  "John Doe",
+					ContactID:    111250,
+					CheckID:      85975,
+					Time:         1294235703,
+					Via:          "email",
+					Status:       "sent",
+					MessageShort: "assign",
+					MessageFull:  "PingdomAlert DOWN: My check 1 (example.com) is down since 2011-01-05 13:55:03",
+					SentTo:       "john@johnsdomain.com",
+					Charged:      false,
+				},
+				AlertEntry{
+					ContactName:  "Jane Doe",
+					ContactID:    111251,
+					CheckID:      85975,
+					Time:         1294235703,
+					Via:          "sms",
+					Status:       "delivered",
+					MessageShort: "assign",
+					MessageFull:  "PingdomAlert DOWN: My check 1 (example.com) is down",
+					SentTo:       "1-604-664-1234",
+					Charged:      true,
+				},
+			},
+		},
+	}
+}
+
+const getActionListOutputText = `
+{
+	"actions": {
+		"alerts": [{
+			"contactname": "John Doe",
+			"contactid": 111250,
+			"checkid": 85975,
+			"time": 1294235703,
+			"via": "email",
+			"status": "sent",
+			"messageshort": "assign",
+			"messagefull": "PingdomAlert DOWN: My check 1 (example.com) is down since 2011-01-05 13:55:03",
+			"sentto": "john@johnsdomain.com",
+			"charged": false
+		}, {
+			"contactname": "Jane Doe",
+			"contactid": 111251,
+			"checkid": 85975,
+			"time": 1294235703,
+			"via": "sms",
+			"status": "delivered",
+			"messageshort": "assign",
+			"messagefull": "PingdomAlert DOWN: My check 1 (example.com) is down",
+			"sentto": "1-604-664-1234",
+			"charged": true
+		}]
+	}
+}
+`
+
+func httpGetActionListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getActionListOutputText, http.StatusOK)
+	})
+}
+
+func TestActionNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestActionNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetActionListQueryText(t *testing.T) {
+	in := getActionListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getActionListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetActionList(t *testing.T) {
+	ts := httpGetActionListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getActionListInputData()
+	out, err := c.GetActionList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getActionListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetActionListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getActionListInputData()
+	_, err := c.GetActionList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analysis contains the methods necessary for retrieving root cause
+// analysis data from Pingdom.
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Analysis is the base client for analysis-related methods.
+type Analysis struct {
+	client.Client
+}
+
+// New returns a new instance of the Analysis API.
+func New(configs ...pingdom.Config) *Analysis {
+	c := &Analysis{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// AnalysisListEntry holds a single analysis from GetAnalysisListOutput.
+type AnalysisListEntry struct {
+	_ struct{}
+
+	// The analysis identifier.
+	ID int
+
+	// The time of the test that triggered the analysis (UNIX timestamp).
+	TimeFirstTest int
+
+	// The time of the test that confirmed the error (UNIX timestamp).
+	TimeConfirmTest int
+}
+
+// GetAnalysisListInput contains the input to send to the GetAnalysisList
+// function.
+type GetAnalysisListInput struct {
+	_ struct{}
+
+	// The ID of the check to get analyses for.
+	CheckID int `url:"-"`
+
+	// Limits the number of returned analyses to the specified quantity.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset for the analysis listing.
+	Offset int `url:"offset,omitempty"`
+
+	// Only return analyses after this time (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// Only return analyses before this time (UNIX timestamp).
+	To int `url:"to,omitempty"`
+}
+
+// GetAnalysisListOutput contains the output for the GetAnalysisList function.
+type GetAnalysisListOutput struct {
+	_ struct{}
+
+	// The list of matched analyses.
+	Analysis []AnalysisListEntry
+}
+
+// GetAnalysisList gets a list of the latest root cause analyses for a
+// specific check.
+func (c *Analysis) GetAnalysisList(in GetAnalysisListInput) (out GetAnalysisListOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/analysis/%d", in.CheckID), &in, &out)
+	return
+}
+
+// AnalysisTaskResult is the result of a single analysis task.
+type AnalysisTaskResult struct {
+	_ struct{}
+
+	// The task status.
+	Status string
+
+	// A description of the task status.
+	StatusDesc string
+
+	// The response time (in milliseconds) of the task, if applicable.
+	ResponseTime int
+
+	// The IP address that the target resolved to, if applicable.
+	IP string
+
+	// The raw output of the task, such as traceroute hops or DNS records.
+	Output string
+}
+
+// AnalysisTask holds a single task (DNS lookup, traceroute, etc) run by a
+// probe during an analysis.
+type AnalysisTask struct {
+	_ struct{}
+
+	// The task identifier.
+	TaskID int
+
+	// The task type, such as dns, ping, traceroute, or http.
+	TaskType string
+
+	// The target of the task.
+	Target string
+
+	// The time the task was run (UNIX timestamp).
+	Time int
+
+	// The task result.
+	Result AnalysisTaskResult
+}
+
+// AnalysisProbeResult holds the tasks run by a single probe during an
+// analysis.
+type AnalysisProbeResult struct {
+	_ struct{}
+
+	// The ID of the probe that ran the tasks.
+	ProbeID int
+
+	// The description of the probe that ran the tasks.
+	ProbeDesc string
+
+	// The time the probe started running the tasks (UNIX timestamp).
+	Time int
+
+	// The tasks run by the probe.
+	Tasks []AnalysisTask
+}
+
+// GetAnalysisDetailInput contains the input to send to the GetAnalysisDetail
+// function.
+type GetAnalysisDetailInput struct {
+	_ struct{}
+
+	// The ID of the check the analysis belongs to.
+	CheckID int
+
+	// The ID of the analysis to get details for.
+	AnalysisID int
+}
+
+// GetAnalysisDetailOutput contains the output for the GetAnalysisDetail
+// function.
+type GetAnalysisDetailOutput struct {
+	_ struct{}
+
+	// The analysis identifier.
+	AnalysisID int
+
+	// The time of the test that triggered the analysis (UNIX timestamp).
+	TimeFirstTest int
+
+	// The time of the test that confirmed the error (UNIX timestamp).
+	TimeConfirmTest int
+
+	// The tasks run by the probe that first detected the error.
+	Initial AnalysisProbeResult
+
+	// The tasks run by each probe that was asked to confirm the error.
+	Confirmations []AnalysisProbeResult
+}
+
+// GetAnalysisDetail gets the raw data for a specific root cause analysis.
+//
+// Note that Pingdom does not formally document the structure of this data,
+// so fields that are not returned for a specific task type are left empty.
+func (c *Analysis) GetAnalysisDetail(in GetAnalysisDetailInput) (out GetAnalysisDetailOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/analysis/%d/%d", in.CheckID, in.AnalysisID), nil, &out)
+	return
+}
@@ -0,0 +1,356 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getAnalysisListInputData() GetAnalysisListInput {
+	return GetAnalysisListInput{
+		CheckID: 85975,
+		Limit:   2,
+		From:    1294235000,
+	}
+}
+
+const getAnalysisListInputText = "from=1294235000&limit=2"
+
+func getAnalysisListOutputData() GetAnalysisListOutput {
+	return GetAnalysisListOutput{
+		Analysis: []AnalysisListEntry{
+			AnalysisListEntry{
+				ID:              7831,
+				TimeFirstTest:   1294235703,
+				TimeConfirmTest: 1294235704,
+			},
+			AnalysisListEntry{
+				ID:              7832,
+				TimeFirstTest:   1294236703,
+				TimeConfirmTest: 1294236705,
+			},
+		},
+	}
+}
+
+const getAnalysisListOutputText = `
+{
+	"analysis": [{
+		"id": 7831,
+		"timefirsttest": 1294235703,
+		"timeconfirmtest": 1294235704
+	}, {
+		"id": 7832,
+		"timefirsttest": 1294236703,
+		"timeconfirmtest": 1294236705
+	}]
+}
+`
+
+func httpGetAnalysisListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getAnalysisListOutputText, http.StatusOK)
+	})
+}
+
+func getAnalysisDetailInputData() GetAnalysisDetailInput {
+	return GetAnalysisDetailInput{
+		CheckID:    85975,
+		AnalysisID: 7831,
+	}
+}
+
+func getAnalysisDetailOutputData() GetAnalysisDetailOutput {
+	return GetAnalysisDetailOutput{
+		AnalysisID:      7831,
+		TimeFirstTest:   1294235703,
+		TimeConfirmTest: 1294235704,
+		Initial: AnalysisProbeResult{
+			ProbeID:   33,
+			ProbeDesc: "Amsterdam 2, Netherlands",
+			Time:      1294235703,
+			Tasks: []AnalysisTask{
+				AnalysisTask{
+					TaskID:   1,
+					TaskType: "dns",
+					Target:   "example.com",
+					Time:     1294235703,
+					Result: AnalysisTaskResult{
+						Status:       "ok",
+						StatusDesc:   "OK",
+						ResponseTime: 12,
+						IP:           "192.0.2.10",
+					},
+				},
+				AnalysisTask{
+					TaskID:   2,
+					TaskType: "traceroute",
+					Target:   "192.0.2.10",
+					Time:     1294235704,
+					Result: AnalysisTaskResult{
+						Status:     "error",
+						StatusDesc: "Destination unreachable",
+						Output:     "1 192.0.2.1 0.345 ms\n2 * * *",
+					},
+				},
+			},
+		},
+		Confirmations: []AnalysisProbeResult{
+			AnalysisProbeResult{
+				ProbeID:   34,
+				ProbeDesc: "Dallas 4, TX",
+				Time:      1294235704,
+				Tasks: []AnalysisTask{
+					AnalysisTask{
+						TaskID:   3,
+						TaskType: "http",
+						Target:   "http://example.com/",
+						Time:     1294235704,
+						Result: AnalysisTaskResult{
+							Status:     "error",
+							StatusDesc: "Timeout",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const getAnalysisDetailOutputText = `
+{
+	"analysisid": 7831,
+	"timefirsttest": 1294235703,
+	"timeconfirmtest": 1294235704,
+	"initial": {
+		"probeid": 33,
+		"probedesc": "Amsterdam 2, Netherlands",
+		"time": 1294235703,
+		"tasks": [{
+			"taskid": 1,
+			"tasktype": "dns",
+			"target": "example.com",
+			"time": 1294235703,
+			"result": {
+				"status": "ok",
+				"statusdesc": "OK",
+				"responsetime": 12,
+				"ip": "192.0.2.10"
+			}
+		}, {
+			"taskid": 2,
+			"tasktype": "traceroute",
+			"target": "192.0.2.10",
+			"time": 1294235704,
+			"result": {
+				"status": "error",
+				"statusdesc": "Destination unreachable",
+				"output": "1 192.0.2.1 0.345 ms\n2 * * *"
+			}
+		}]
+	},
+	"confirmations": [{
+		"probeid": 34,
+		"probedesc": "Dallas 4, TX",
+		"time": 1294235704,
+		"tasks": [{
+			"taskid": 3,
+			"tasktype": "http",
+			"target": "http://example.com/",
+			"time": 1294235704,
+			"result": {
+				"status": "error",
+				"statusdesc": "Timeout"
+			}
+		}]
+	}]
+}
+`
+
+func httpGetAnalysisDetailTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getAnalysisDetailOutputText, http.StatusOK)
+	})
+}
+
+func TestAnalysisNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestAnalysisNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetAnalysisListQueryText(t *testing.T) {
+	in := getAnalysisListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getAnalysisListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetAnalysisList(t *testing.T) {
+	ts := httpGetAnalysisListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAnalysisListInputData()
+	out, err := c.GetAnalysisList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getAnalysisListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetAnalysisListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAnalysisListInputData()
+	_, err := c.GetAnalysisList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetAnalysisDetail(t *testing.T) {
+	ts := httpGetAnalysisDetailTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAnalysisDetailInputData()
+	out, err := c.GetAnalysisDetail(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getAnalysisDetailOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetAnalysisDetailError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAnalysisDetailInputData()
+	_, err := c.GetAnalysisDetail(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
@@ -16,8 +16,12 @@
 package checks
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/google/go-querystring/query"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
 )
@@ -156,6 +160,13 @@ type DetailedCheckEntryHTTP struct {
 
 	// Custom headers to send with the HTTP request.
 	RequestHeaders map[string]string
+
+	// true if the certificate of the server is validated.
+	VerifyCertificate bool `json:"verify_certificate"`
+
+	// The number of days before certificate expiry that the check is
+	// treated as down.
+	SSLDownDaysBefore int `json:"ssl_down_days_before"`
 }
 
 // DetailedCheckEntryHTTPCustom is the Custom HTTP check details for the data
@@ -321,6 +332,26 @@ type DetailedCheckEntry struct {
 	// A list of contact IDs that receive alerts.
 	ContactIDs []int
 
+	// A list of team IDs that receive alerts.
+	TeamIDs []int
+
+	// A list of user IDs that receive alerts.
+	UserIDs []int
+
+	// A list of integration IDs (such as webhooks) that receive alerts.
+	IntegrationIDs []int
+
+	// The filters used to select probes, as key:value pairs. For example:
+	// region:EU.
+	ProbeFilters []string `json:"probe_filters"`
+
+	// The response time, in milliseconds, above which the check is treated
+	// as down.
+	ResponseTimeThreshold int `json:"responsetime_threshold"`
+
+	// A custom message included in alerts.
+	CustomMessage string `json:"custom_message"`
+
 	// Send alerts as email.
 	SendToEmail bool
 
@@ -413,22 +444,44 @@ type CheckConfiguration struct {
 	// 1, 5, 15, 30, or 60.
 	Resolution int `url:"resolution,omitempty"`
 
-	// An array of contact IDs.
+	// An array of contact IDs. Not sent when using an API token, as version
+	// 3.1 of the API alerts users and teams instead.
 	ContactIDs []int `url:"contactids,comma,omitempty"`
 
-	// Send alerts as email.
+	// An array of team IDs. All members of each team receive alerts.
+	TeamIDs []int `url:"teamids,comma,omitempty"`
+
+	// An array of user IDs.
+	UserIDs []int `url:"userids,comma,omitempty"`
+
+	// An array of integration IDs, such as webhooks.
+	IntegrationIDs []int `url:"integrationids,comma,omitempty"`
+
+	// Filters used to select probes, as key:value pairs. Currently only
+	// region is supported, with one of NA, EU, APAC, or LATAM. For example:
+	// region:EU.
+	ProbeFilters []string `url:"probe_filters,comma,omitempty"`
+
+	// Treat the check as down if the response time exceeds this threshold,
+	// in milliseconds.
+	ResponseTimeThreshold int `url:"responsetime_threshold,omitempty"`
+
+	// A custom message included in alerts.
+	CustomMessage string `url:"custom_message,omitempty"`
+
+	// Send alerts as email. Not sent when using an API token.
 	SendToEmail bool `url:"sendtoemail,omitempty"`
 
-	// Send alerts as SMS.
+	// Send alerts as SMS. Not sent when using an API token.
 	SendToSMS bool `url:"sendtosms,omitempty"`
 
-	// Send alerts through Twitter.
+	// Send alerts through Twitter. Not sent when using an API token.
 	SendToTwitter bool `url:"sendtotwitter,omitempty"`
 
-	// Send alerts to iPhone.
+	// Send alerts to iPhone. Not sent when using an API token.
 	SendToIphone bool `url:"sendtoiphone,omitempty"`
 
-	// Send alerts to Android.
+	// Send alerts to Android. Not sent when using an API token.
 	SendToAndroid bool `url:"sendtoandroid,omitempty"`
 
 	// The failure count threshold to send notifications on.
@@ -484,6 +537,15 @@ type CheckConfigurationHTTP struct {
 	// Custom headers to send with the HTTP request. Required in name: value
 	// pairs.
 	RequestHeaders []string `url:"requestheader,numbered,omitempty"`
+
+	// Set to false to skip validation of the server certificate. Left unset,
+	// certificates are validated on creation and the setting is unchanged on
+	// modification.
+	VerifyCertificate *bool `url:"verify_certificate,omitempty"`
+
+	// Treat the check as down this many days before the server certificate
+	// expires.
+	SSLDownDaysBefore int `url:"ssl_down_days_before,omitempty"`
 }
 
 // CheckConfigurationHTTPCustom contains check configuration data specific for
@@ -649,7 +711,15 @@ type CreateCheckEntry struct {
 }
 
 // CreateCheck creates a Pingdom service check.
+//
+// When using an API token, the check is sent to version 3.1 of the API as
+// JSON. ContactIDs and the SendTo settings have no version 3.1 equivalent
+// and are not sent.
 func (c *Check) CreateCheck(in CreateCheckInput) (out CreateCheckOutput, err error) {
+	if c.Config.APIToken != "" {
+		err = c.SendJSONRequest("POST", checksTokenURI, newCheckInputV3(&in), &out)
+		return
+	}
 	err = c.SendRequest("POST", "/api/2.0/checks", &in, &out)
 	return
 }
@@ -686,11 +756,77 @@ type ModifyCheckOutput struct {
 // The provided settings will overwrite previous values. To clear an existing
 // value, provide an empty value. Note that you cannot change the type of a
 // check once it's created.
+//
+// When using an API token, the check is sent to version 3.1 of the API as
+// JSON, in the same way as CreateCheck.
 func (c *Check) ModifyCheck(in ModifyCheckInput) (out ModifyCheckOutput, err error) {
+	if c.Config.APIToken != "" {
+		err = c.SendJSONRequest("PUT", fmt.Sprintf("%s/%d", checksTokenURI, in.CheckID), newCheckInputV3(&in), &out)
+		return
+	}
 	err = c.SendRequest("PUT", fmt.Sprintf("/api/2.0/checks/%d", in.CheckID), &in, &out)
 	return
 }
 
+// ModifyChecksInput is the input for the ModifyChecks function.
+type ModifyChecksInput struct {
+	_ struct{}
+
+	// The IDs of the checks to modify. At least one ID is required.
+	CheckIDs []int `url:"checkids,comma"`
+
+	// Set to true to pause the checks, or false to resume them. Left unset,
+	// the paused state of the checks is not changed.
+	Paused *bool `url:"paused,omitempty"`
+
+	// The new resolution of the checks. Can be one of
+	// 1, 5, 15, 30, or 60.
+	Resolution int `url:"resolution,omitempty"`
+
+	// Tags to add to the checks.
+	AddTags []string `url:"addtags,comma,omitempty"`
+
+	// Tags to remove from the checks.
+	RemoveTags []string `url:"removetags,comma,omitempty"`
+}
+
+// errNoCheckIDs is returned by ModifyChecks when no check IDs are given, as
+// Pingdom would otherwise apply the change to every check on the account.
+var errNoCheckIDs = errors.New("At least one check ID is required to modify checks")
+
+// ModifyChecksOutput is the output for the ModifyChecks function.
+type ModifyChecksOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyChecks modifies several checks at once.
+//
+// Unlike ModifyCheck, only the paused state, resolution, and tags can be
+// changed. An error is returned without contacting Pingdom if CheckIDs is
+// empty.
+//
+// When using an API token, only the paused state and resolution can be
+// changed, and an error is returned if AddTags or RemoveTags is set.
+func (c *Check) ModifyChecks(in ModifyChecksInput) (out ModifyChecksOutput, err error) {
+	if len(in.CheckIDs) == 0 {
+		err = errNoCheckIDs
+		return
+	}
+	if c.Config.APIToken != "" {
+		if len(in.AddTags) > 0 || len(in.RemoveTags) > 0 {
+			err = errBulkTagsNotSupported
+			return
+		}
+		err = c.SendJSONRequest("PUT", checksTokenURI, newModifyChecksInputV3(in), &out)
+		return
+	}
+	err = c.SendRequest("PUT", "/api/2.0/checks", &in, &out)
+	return
+}
+
 // DeleteCheckInput is the input to send to the DeleteCheck method.
 type DeleteCheckInput struct {
 	_ struct{}
@@ -712,3 +848,243 @@ func (c *Check) DeleteCheck(in DeleteCheckInput) (out DeleteCheckOutput, err err
 	err = c.SendRequest("DELETE", fmt.Sprintf("/api/2.0/checks/%d", in.CheckID), nil, &out)
 	return
 }
+
+// DeleteChecksInput is the input to send to the DeleteChecks method.
+type DeleteChecksInput struct {
+	_ struct{}
+
+	// The IDs of the checks that you want to delete. At least one ID is
+	// required.
+	CheckIDs []int `url:"delcheckids,comma"`
+}
+
+// errNoDeleteCheckIDs is returned by DeleteChecks when no check IDs are
+// given.
+var errNoDeleteCheckIDs = errors.New("At least one check ID is required to delete checks")
+
+// DeleteChecksOutput is the output for the DeleteChecks method.
+type DeleteChecksOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteChecks deletes several checks from Pingdom at once.
+//
+// The API only reports overall success - the outcome of each individual
+// check is not returned. Use GetCheckList afterwards if you need to confirm
+// which checks are gone. An error is returned without contacting Pingdom if
+// CheckIDs is empty.
+func (c *Check) DeleteChecks(in DeleteChecksInput) (out DeleteChecksOutput, err error) {
+	if len(in.CheckIDs) == 0 {
+		err = errNoDeleteCheckIDs
+		return
+	}
+	if c.Config.APIToken != "" {
+		// Version 3.1 takes the check IDs in the query string.
+		v, _ := query.Values(in)
+		err = c.SendRequest("DELETE", fmt.Sprintf("%s?%s", checksTokenURI, v.Encode()), nil, &out)
+		return
+	}
+	err = c.SendRequest("DELETE", "/api/2.0/checks", &in, &out)
+	return
+}
+
+// SingleTestInput is the input for the RunSingleTest function.
+//
+// The type-specific configuration structs are the same ones used by
+// CreateCheckInput and ModifyCheckInput. Only the one matching Type needs to
+// be supplied.
+type SingleTestInput struct {
+	_ struct{}
+
+	// The target hostname or IP address.
+	Host string `url:"host"`
+
+	// The type of test. See CheckConfiguration for valid values.
+	Type string `url:"type"`
+
+	// The ID of the probe to run the test from. Defaults to a random probe.
+	ProbeID int `url:"probeid,omitempty"`
+
+	// Use IPv6 instead of IPv4.
+	IPv6 bool `url:"ipv6,omitempty"`
+
+	CheckConfigurationHTTP
+	CheckConfigurationHTTPCustom
+	CheckConfigurationTCP
+	CheckConfigurationPing
+	CheckConfigurationDNS
+	CheckConfigurationUDP
+	CheckConfigurationSMTP
+	CheckConfigurationPOP3
+	CheckConfigurationIMAP
+}
+
+// NewSingleTestInput returns a SingleTestInput that tests the same host,
+// type, and type-specific settings as the supplied CreateCheckInput. This can
+// be used to confirm that a check definition passes before creating it.
+func NewSingleTestInput(in CreateCheckInput) SingleTestInput {
+	return SingleTestInput{
+		Host:                         in.Host,
+		Type:                         in.Type,
+		IPv6:                         in.IPv6,
+		CheckConfigurationHTTP:       in.CheckConfigurationHTTP,
+		CheckConfigurationHTTPCustom: in.CheckConfigurationHTTPCustom,
+		CheckConfigurationTCP:        in.CheckConfigurationTCP,
+		CheckConfigurationPing:       in.CheckConfigurationPing,
+		CheckConfigurationDNS:        in.CheckConfigurationDNS,
+		CheckConfigurationUDP:        in.CheckConfigurationUDP,
+		CheckConfigurationSMTP:       in.CheckConfigurationSMTP,
+		CheckConfigurationPOP3:       in.CheckConfigurationPOP3,
+		CheckConfigurationIMAP:       in.CheckConfigurationIMAP,
+	}
+}
+
+// SingleTestResult is the actual test data in the output of RunSingleTest.
+type SingleTestResult struct {
+	_ struct{}
+
+	// The test result status. One of up or down.
+	Status string
+
+	// The response time (in milliseconds) of the test.
+	ResponseTime int
+
+	// A short status description.
+	StatusDesc string
+
+	// A long status description.
+	StatusDescLong string
+
+	// The ID of the probe that ran the test.
+	ProbeID int
+
+	// The description of the probe that ran the test.
+	ProbeDesc string
+}
+
+// SingleTestOutput is the output for the RunSingleTest function.
+type SingleTestOutput struct {
+	_ struct{}
+
+	// The test result.
+	Result SingleTestResult
+}
+
+// RunSingleTest performs a single test against a host, without creating a
+// check. The test is run synchronously, so this function returns once the
+// test has completed.
+func (c *Check) RunSingleTest(in SingleTestInput) (out SingleTestOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/single", &in, &out)
+	return
+}
+
+// checksTokenURI is the version 3.1 checks endpoint. Requests that take a
+// body are sent here directly, as their data differs between versions.
+const checksTokenURI = "/api/3.1/checks"
+
+// errBulkTagsNotSupported is returned by ModifyChecks when changing tags
+// with an API token.
+var errBulkTagsNotSupported = errors.New("Bulk tag changes are not supported by version 3.1 of the Pingdom API")
+
+// legacyCheckParams are the version 2.0 check parameters that have no
+// version 3.1 equivalent.
+var legacyCheckParams = map[string]bool{
+	"contactids":    true,
+	"sendtoemail":   true,
+	"sendtosms":     true,
+	"sendtotwitter": true,
+	"sendtoiphone":  true,
+	"sendtoandroid": true,
+}
+
+// Version 3.1 check parameters that are not strings, by JSON type.
+var (
+	checkIntParams = map[string]bool{
+		"resolution":               true,
+		"responsetime_threshold":   true,
+		"sendnotificationwhendown": true,
+		"notifyagainevery":         true,
+		"port":                     true,
+		"ssl_down_days_before":     true,
+	}
+	checkBoolParams = map[string]bool{
+		"paused":             true,
+		"notifywhenbackup":   true,
+		"ipv6":               true,
+		"encryption":         true,
+		"verify_certificate": true,
+	}
+	checkIntListParams = map[string]bool{
+		"teamids":        true,
+		"userids":        true,
+		"integrationids": true,
+	}
+	checkStringListParams = map[string]bool{
+		"tags":          true,
+		"probe_filters": true,
+	}
+)
+
+// newCheckInputV3 translates a CreateCheckInput or ModifyCheckInput into a
+// version 3.1 request body. The input is encoded the same way as for version
+// 2.0 first, as the type-specific configurations share parameter names and
+// only the ones that are set are wanted.
+func newCheckInputV3(in interface{}) map[string]interface{} {
+	v, _ := query.Values(in)
+	body := make(map[string]interface{})
+	headers := make(map[string]string)
+	for k, vs := range v {
+		s := vs[0]
+		switch {
+		case legacyCheckParams[k]:
+		case strings.HasPrefix(k, "requestheader"):
+			h := strings.SplitN(s, ":", 2)
+			if len(h) == 2 {
+				headers[strings.TrimSpace(h[0])] = strings.TrimSpace(h[1])
+			}
+		case checkIntParams[k]:
+			body[k], _ = strconv.Atoi(s)
+		case checkBoolParams[k]:
+			body[k] = s == "true"
+		case checkIntListParams[k]:
+			var ids []int
+			for _, id := range strings.Split(s, ",") {
+				i, _ := strconv.Atoi(id)
+				ids = append(ids, i)
+			}
+			body[k] = ids
+		case checkStringListParams[k]:
+			body[k] = strings.Split(s, ",")
+		default:
+			body[k] = s
+		}
+	}
+	if len(headers) > 0 {
+		body["requestheaders"] = headers
+	}
+	return body
+}
+
+// modifyChecksInputV3 is the body of a version 3.1 bulk check modification.
+type modifyChecksInputV3 struct {
+	CheckIDs   string `json:"checkids"`
+	Paused     *bool  `json:"paused,omitempty"`
+	Resolution int    `json:"resolution,omitempty"`
+}
+
+// newModifyChecksInputV3 translates a ModifyChecksInput into a version 3.1
+// request body.
+func newModifyChecksInputV3(in ModifyChecksInput) modifyChecksInputV3 {
+	ids := make([]string, len(in.CheckIDs))
+	for i, id := range in.CheckIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return modifyChecksInputV3{
+		CheckIDs:   strings.Join(ids, ","),
+		Paused:     in.Paused,
+		Resolution: in.Resolution,
+	}
+}
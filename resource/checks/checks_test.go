@@ -15,6 +15,8 @@
 package checks
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -55,12 +57,14 @@ func setPingdomenv() {
 	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
 	os.Setenv("PINGDOM_PASSWORD", "changeit")
 	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func unsetPingdomenv() {
 	os.Unsetenv("PINGDOM_EMAIL_ADDRESS")
 	os.Unsetenv("PINGDOM_PASSWORD")
 	os.Unsetenv("PINGDOM_APP_KEY")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func pingdomConfig() pingdom.Config {
@@ -207,6 +211,36 @@ func httpGetCheckListTestServer() *httptest.Server {
 	})
 }
 
+func httpGetCheckListTokenTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.1/checks" || r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getCheckListOutputText, http.StatusOK)
+	})
+}
+
+// httpCheckTokenTestServer serves a single version 3.1 checks request, and
+// fails it if the method, URI, token, or JSON body differ from the ones
+// given.
+func httpCheckTokenTestServer(method, uri, body, resp string) *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if r.Method != method || r.URL.RequestURI() != uri || string(b) != body || r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		if body != "" && r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, resp, http.StatusOK)
+	})
+}
+
 func getDetailedCheckInputData() GetDetailedCheckInput {
 	return GetDetailedCheckInput{
 		CheckID: 85975,
@@ -228,9 +262,17 @@ func getDetailedCheckOutputData() GetDetailedCheckOutput {
 					RequestHeaders: map[string]string{
 						"User-Agent": "Pingdom.com_bot_version_1.4_(http://www.pingdom.com/)",
 					},
+					VerifyCertificate: true,
+					SSLDownDaysBefore: 14,
 				},
 			},
 			ContactIDs:               []int{1234, 5678},
+			TeamIDs:                  []int{12, 34},
+			UserIDs:                  []int{111250},
+			IntegrationIDs:           []int{42},
+			ProbeFilters:             []string{"region:EU"},
+			ResponseTimeThreshold:    30000,
+			CustomMessage:            "Call the on-call engineer",
 			SendToEmail:              false,
 			SendToSMS:                false,
 			SendToTwitter:            false,
@@ -268,14 +310,22 @@ const getDetailedCheckOutputText = `
 				"port": 80,
 				"requestheaders": {
 					"User-Agent": "Pingdom.com_bot_version_1.4_(http://www.pingdom.com/)"
-				}
+				},
+				"verify_certificate": true,
+				"ssl_down_days_before": 14
 			}
 		},
 		"hostname": "s7.mydomain.com",
 		"status": "up",
 		"lasterrortime": 1293143467,
 		"lasttesttime": 1294064823,
-		"contactids": [1234, 5678]
+		"contactids": [1234, 5678],
+		"teamids": [12, 34],
+		"userids": [111250],
+		"integrationids": [42],
+		"probe_filters": ["region:EU"],
+		"responsetime_threshold": 30000,
+		"custom_message": "Call the on-call engineer"
 	}
 }
 `
@@ -386,6 +436,29 @@ func createCheckInputPingData() CreateCheckInput {
 
 const checkConfigurationPingText = "contactids=1234%2C5678&host=example.com&name=My+check&notifyagainevery=1&notifywhenbackup=true&paused=true&resolution=1&sendnotificationwhendown=2&sendtoandroid=true&sendtoemail=true&sendtoiphone=true&sendtosms=true&sendtotwitter=true&tags=foo%2Cbar&type=ping"
 
+func createCheckInputTeamIDsData() CreateCheckInput {
+	c := createCheckInputPingData()
+	c.TeamIDs = []int{12, 34}
+	return c
+}
+
+func createCheckInputAlertingData() CreateCheckInput {
+	verify := false
+	c := createCheckInputHTTPData()
+	c.UserIDs = []int{111250}
+	c.IntegrationIDs = []int{42, 43}
+	c.ProbeFilters = []string{"region:EU"}
+	c.ResponseTimeThreshold = 30000
+	c.CustomMessage = "Call the on-call engineer"
+	c.VerifyCertificate = &verify
+	c.SSLDownDaysBefore = 14
+	return c
+}
+
+const checkConfigurationAlertingText = "auth=foo%3Abar&contactids=1234%2C5678&custom_message=Call+the+on-call+engineer&encryption=true&host=example.com&integrationids=42%2C43&name=My+check&notifyagainevery=1&notifywhenbackup=true&paused=true&port=443&postdata=baz&probe_filters=region%3AEU&requestheader0=X-Header1%3Afoo&requestheader1=X-Header2%3Abar&requestheader2=X-Header3%3Abaz&resolution=1&responsetime_threshold=30000&sendnotificationwhendown=2&sendtoandroid=true&sendtoemail=true&sendtoiphone=true&sendtosms=true&sendtotwitter=true&shouldcontain=foo&shouldnotcontain=bar&ssl_down_days_before=14&tags=foo%2Cbar&type=http&url=%2Ftest&userids=111250&verify_certificate=false"
+
+const checkConfigurationTeamIDsText = "contactids=1234%2C5678&host=example.com&name=My+check&notifyagainevery=1&notifywhenbackup=true&paused=true&resolution=1&sendnotificationwhendown=2&sendtoandroid=true&sendtoemail=true&sendtoiphone=true&sendtosms=true&sendtotwitter=true&tags=foo%2Cbar&teamids=12%2C34&type=ping"
+
 func checkConfigurationDNSData() CheckConfigurationDNS {
 	return CheckConfigurationDNS{
 		NameServer: "ns1.example.com",
@@ -514,6 +587,26 @@ func modifyCheckInputHTTPData() ModifyCheckInput {
 	return c
 }
 
+const checkInputHTTPTokenText = `{"auth":"foo:bar","encryption":true,"host":"example.com","name":"My check",` +
+	`"notifyagainevery":1,"notifywhenbackup":true,"paused":true,"port":443,"postdata":"baz",` +
+	`"requestheaders":{"X-Header1":"foo","X-Header2":"bar","X-Header3":"baz"},"resolution":1,` +
+	`"sendnotificationwhendown":2,"shouldcontain":"foo","shouldnotcontain":"bar","tags":["foo","bar"],"type":"http","url":"/test"}`
+
+const modifyCheckInputHTTPTokenText = `{"auth":"foo:bar","encryption":true,"host":"example.com","name":"My check",` +
+	`"notifyagainevery":1,"notifywhenbackup":true,"paused":true,"port":443,"postdata":"baz",` +
+	`"requestheaders":{"X-Header1":"foo","X-Header2":"bar","X-Header3":"baz"},"resolution":1,` +
+	`"sendnotificationwhendown":2,"shouldcontain":"foo","shouldnotcontain":"bar","tags":["foo","bar"],"url":"/test"}`
+
+const checkInputAlertingTokenText = `{"auth":"foo:bar","custom_message":"Call the on-call engineer","encryption":true,` +
+	`"host":"example.com","integrationids":[42,43],"name":"My check","notifyagainevery":1,"notifywhenbackup":true,` +
+	`"paused":true,"port":443,"postdata":"baz","probe_filters":["region:EU"],` +
+	`"requestheaders":{"X-Header1":"foo","X-Header2":"bar","X-Header3":"baz"},"resolution":1,` +
+	`"responsetime_threshold":30000,"sendnotificationwhendown":2,"shouldcontain":"foo","shouldnotcontain":"bar",` +
+	`"ssl_down_days_before":14,"tags":["foo","bar"],"type":"http","url":"/test","userids":[111250],"verify_certificate":false}`
+
+const checkInputTeamIDsTokenText = `{"host":"example.com","name":"My check","notifyagainevery":1,"notifywhenbackup":true,` +
+	`"paused":true,"resolution":1,"sendnotificationwhendown":2,"tags":["foo","bar"],"teamids":[12,34],"type":"ping"}`
+
 func modifyCheckInputHTTPCustomData() ModifyCheckInput {
 	c := ModifyCheckInput{
 		CheckConfiguration:           checkConfigurationData(),
@@ -597,6 +690,50 @@ func httpModifyCheckTestServer() *httptest.Server {
 	})
 }
 
+func modifyChecksInputData() ModifyChecksInput {
+	paused := true
+	return ModifyChecksInput{
+		CheckIDs:   []int{134536, 134537},
+		Paused:     &paused,
+		Resolution: 5,
+		AddTags:    []string{"incident"},
+		RemoveTags: []string{"nightly", "staging"},
+	}
+}
+
+const modifyChecksInputText = "addtags=incident&checkids=134536%2C134537&paused=true&removetags=nightly%2Cstaging&resolution=5"
+
+func modifyChecksResumeInputData() ModifyChecksInput {
+	paused := false
+	return ModifyChecksInput{
+		CheckIDs: []int{134536, 134537},
+		Paused:   &paused,
+	}
+}
+
+const modifyChecksResumeInputText = "checkids=134536%2C134537&paused=false"
+
+const modifyChecksResumeTokenText = `{"checkids":"134536,134537","paused":false}`
+
+func modifyChecksOutputData() ModifyChecksOutput {
+	return ModifyChecksOutput{
+		Message: "Modification of 2 checks was successful!",
+	}
+}
+
+const modifyChecksOutputText = `
+{
+	"message": "Modification of 2 checks was successful!"
+}
+`
+
+func httpModifyChecksTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyChecksOutputText, http.StatusOK)
+	})
+}
+
 func deleteCheckInputData() DeleteCheckInput {
 	return DeleteCheckInput{
 		CheckID: 134536,
@@ -622,6 +759,77 @@ func httpDeleteCheckTestServer() *httptest.Server {
 	})
 }
 
+func deleteChecksInputData() DeleteChecksInput {
+	return DeleteChecksInput{
+		CheckIDs: []int{134536, 134537},
+	}
+}
+
+const deleteChecksInputText = "delcheckids=134536%2C134537"
+
+func deleteChecksOutputData() DeleteChecksOutput {
+	return DeleteChecksOutput{
+		Message: "Deletion of checks was successful!",
+	}
+}
+
+const deleteChecksOutputText = `
+{
+	"message": "Deletion of checks was successful!"
+}
+`
+
+func httpDeleteChecksTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteChecksOutputText, http.StatusOK)
+	})
+}
+
+func runSingleTestInputData() SingleTestInput {
+	return SingleTestInput{
+		Host:                   "example.com",
+		Type:                   "http",
+		ProbeID:                33,
+		CheckConfigurationHTTP: checkConfigurationHTTPData(),
+	}
+}
+
+const runSingleTestInputText = "auth=foo%3Abar&encryption=true&host=example.com&port=443&postdata=baz&probeid=33&requestheader0=X-Header1%3Afoo&requestheader1=X-Header2%3Abar&requestheader2=X-Header3%3Abaz&shouldcontain=foo&shouldnotcontain=bar&type=http&url=%2Ftest"
+
+func runSingleTestOutputData() SingleTestOutput {
+	return SingleTestOutput{
+		Result: SingleTestResult{
+			Status:         "up",
+			ResponseTime:   124,
+			StatusDesc:     "OK",
+			StatusDescLong: "OK",
+			ProbeID:        33,
+			ProbeDesc:      "Amsterdam 2, Netherlands",
+		},
+	}
+}
+
+const runSingleTestOutputText = `
+{
+	"result": {
+		"status": "up",
+		"responsetime": 124,
+		"statusdesc": "OK",
+		"statusdesclong": "OK",
+		"probeid": 33,
+		"probedesc": "Amsterdam 2, Netherlands"
+	}
+}
+`
+
+func httpRunSingleTestTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, runSingleTestOutputText, http.StatusOK)
+	})
+}
+
 func TestCheckNewWithEnv(t *testing.T) {
 	setPingdomenv()
 	c := New()
@@ -687,6 +895,27 @@ func TestGetCheckList(t *testing.T) {
 	}
 }
 
+func TestGetCheckListToken(t *testing.T) {
+	ts := httpGetCheckListTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := getCheckListInputData()
+	out, err := c.GetCheckList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getCheckListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
 func TestGetCheckListError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
@@ -788,6 +1017,27 @@ func TestCreateCheck(t *testing.T) {
 	}
 }
 
+func TestCreateCheckToken(t *testing.T) {
+	ts := httpCheckTokenTestServer("POST", "/api/3.1/checks", checkInputHTTPTokenText, createCheckOutputText)
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := createCheckInputHTTPData()
+	out, err := c.CreateCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
 func TestCreateCheckError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
@@ -852,6 +1102,28 @@ func TestCheckConfigurationPingQueryText(t *testing.T) {
 	}
 }
 
+func TestCheckConfigurationAlertingQueryText(t *testing.T) {
+	in := createCheckInputAlertingData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := checkConfigurationAlertingText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCheckConfigurationTeamIDsQueryText(t *testing.T) {
+	in := createCheckInputTeamIDsData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := checkConfigurationTeamIDsText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
 func TestCheckConfigurationDNSQueryText(t *testing.T) {
 	in := createCheckInputDNSData()
 	v, _ := query.Values(in)
@@ -907,6 +1179,24 @@ func TestCheckConfigurationIMAPQueryText(t *testing.T) {
 	}
 }
 
+func TestCheckInputV3JSONText(t *testing.T) {
+	for _, tc := range []struct {
+		in       CreateCheckInput
+		expected string
+	}{
+		{in: createCheckInputHTTPData(), expected: checkInputHTTPTokenText},
+		{in: createCheckInputAlertingData(), expected: checkInputAlertingTokenText},
+		{in: createCheckInputTeamIDsData(), expected: checkInputTeamIDsTokenText},
+	} {
+		b, _ := json.Marshal(newCheckInputV3(&tc.in))
+		out := string(b)
+
+		if out != tc.expected {
+			t.Fatalf("Expected %s, got %s", tc.expected, out)
+		}
+	}
+}
+
 func TestModifyCheck(t *testing.T) {
 	ts := httpModifyCheckTestServer()
 	defer ts.Close()
@@ -927,6 +1217,28 @@ func TestModifyCheck(t *testing.T) {
 	}
 }
 
+func TestModifyCheckToken(t *testing.T) {
+	ts := httpCheckTokenTestServer("PUT", "/api/3.1/checks/134536", modifyCheckInputHTTPTokenText, modifyCheckOutputText)
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := modifyCheckInputHTTPData()
+	in.CheckID = 134536
+	out, err := c.ModifyCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
 func TestModifyCheckError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
@@ -947,6 +1259,116 @@ func TestModifyCheckError(t *testing.T) {
 	}
 }
 
+func TestModifyChecksQueryText(t *testing.T) {
+	in := modifyChecksInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyChecksInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyChecksResumeQueryText(t *testing.T) {
+	in := modifyChecksResumeInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyChecksResumeInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyChecks(t *testing.T) {
+	ts := httpModifyChecksTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyChecksInputData()
+	out, err := c.ModifyChecks(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyChecksOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyChecksToken(t *testing.T) {
+	ts := httpCheckTokenTestServer("PUT", "/api/3.1/checks", modifyChecksResumeTokenText, modifyChecksOutputText)
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := modifyChecksResumeInputData()
+	out, err := c.ModifyChecks(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyChecksOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyChecksTagsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := modifyChecksInputData()
+	_, err := c.ModifyChecks(in)
+
+	if err != errBulkTagsNotSupported {
+		t.Fatalf("expected %v, got %v", errBulkTagsNotSupported, err)
+	}
+}
+
+func TestModifyChecksError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyChecksInputData()
+	_, err := c.ModifyChecks(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyChecksNoCheckIDs(t *testing.T) {
+	ts := httpModifyChecksTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyChecksInputData()
+	in.CheckIDs = []int{}
+	_, err := c.ModifyChecks(in)
+
+	if err != errNoCheckIDs {
+		t.Fatalf("expected %v, got %v", errNoCheckIDs, err)
+	}
+}
+
 func TestDeleteCheck(t *testing.T) {
 	ts := httpDeleteCheckTestServer()
 	defer ts.Close()
@@ -967,6 +1389,27 @@ func TestDeleteCheck(t *testing.T) {
 	}
 }
 
+func TestDeleteCheckToken(t *testing.T) {
+	ts := httpCheckTokenTestServer("DELETE", "/api/3.1/checks/134536", "", deleteCheckOutputText)
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := deleteCheckInputData()
+	out, err := c.DeleteCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
 func TestDeleteCheckError(t *testing.T) {
 	ts := httpErrorTestServer()
 	defer ts.Close()
@@ -987,6 +1430,154 @@ func TestDeleteCheckError(t *testing.T) {
 	}
 }
 
+func TestDeleteChecksQueryText(t *testing.T) {
+	in := deleteChecksInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := deleteChecksInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestDeleteChecks(t *testing.T) {
+	ts := httpDeleteChecksTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteChecksInputData()
+	out, err := c.DeleteChecks(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteChecksOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteChecksToken(t *testing.T) {
+	ts := httpCheckTokenTestServer("DELETE", "/api/3.1/checks?"+deleteChecksInputText, "", deleteChecksOutputText)
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	in := deleteChecksInputData()
+	out, err := c.DeleteChecks(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteChecksOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteChecksError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteChecksInputData()
+	_, err := c.DeleteChecks(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteChecksNoCheckIDs(t *testing.T) {
+	ts := httpDeleteChecksTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteChecksInputData()
+	in.CheckIDs = []int{}
+	_, err := c.DeleteChecks(in)
+
+	if err != errNoDeleteCheckIDs {
+		t.Fatalf("expected %v, got %v", errNoDeleteCheckIDs, err)
+	}
+}
+
+func TestRunSingleTestQueryText(t *testing.T) {
+	in := runSingleTestInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := runSingleTestInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestNewSingleTestInput(t *testing.T) {
+	in := NewSingleTestInput(createCheckInputHTTPData())
+	expected := runSingleTestInputData()
+	expected.ProbeID = 0
+
+	if reflect.DeepEqual(expected, in) == false {
+		t.Fatalf("expected %v, got %v", expected, in)
+	}
+}
+
+func TestRunSingleTest(t *testing.T) {
+	ts := httpRunSingleTestTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := runSingleTestInputData()
+	out, err := c.RunSingleTest(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := runSingleTestOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestRunSingleTestError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := runSingleTestInputData()
+	_, err := c.RunSingleTest(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
 // testAccChecksCRUDCreate runs the Create section of the CRUD test
 // (using CreateCheck).
 func testAccChecksCRUDCreate(t *testing.T, in CreateCheckInput) int {
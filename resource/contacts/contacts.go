@@ -14,13 +14,22 @@
 
 // Package contacts contains the methods necessary for managing contacts at
 // Pingdom.
+//
+// When the client is configured with an API token, requests are translated to
+// the alerting contacts endpoints of version 3.1 of the API, which model a
+// contact as a set of email and SMS notification targets. Fields that have
+// no equivalent in version 3.1, such as CountryISO and the Twitter settings,
+// are ignored, and the bulk ModifyContacts and DeleteContacts functions are
+// not available.
 package contacts
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/paybyphone/pingdom-go-sdk/pingdom"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+	"github.com/paybyphone/pingdom-go-sdk/resource/reference"
 )
 
 // Contact is the base client for contact-related methods.
@@ -98,8 +107,11 @@ type GetContactListOutput struct {
 }
 
 // GetContactList gets a list of available contacts based on a specific set of
-// filters.
+// filters. Limit and Offset are ignored when using an API token.
 func (c *Contact) GetContactList(in GetContactListInput) (out GetContactListOutput, err error) {
+	if c.Config.APIToken != "" {
+		return c.getContactListV3()
+	}
 	err = c.SendRequest("GET", "/api/2.0/notification_contacts", &in, &out)
 	return
 }
@@ -141,6 +153,19 @@ type ContactConfiguration struct {
 	TwitterUser string `url:"twitteruser,omitempty"`
 }
 
+// Validate checks the phone settings in the configuration against Pingdom
+// reference data, as returned by reference.GetReference. Only the fields that
+// are set are checked.
+func (in ContactConfiguration) Validate(ref reference.GetReferenceOutput) error {
+	if in.CellPhone != "" && in.CountryCode == "" && in.CountryISO == "" {
+		return fmt.Errorf("Country code and country ISO code are required with cell phone %s", in.CellPhone)
+	}
+	if in.CountryCode != "" || in.CountryISO != "" {
+		return ref.ValidatePhone(in.CountryCode, in.CountryISO)
+	}
+	return nil
+}
+
 // CreateContactInput contains the input for the CreateContact function.
 type CreateContactInput struct {
 	_ struct{}
@@ -169,6 +194,9 @@ type createContactEntry struct {
 
 // CreateContact creates a contact for use with other Pingdom resources, such as checks.
 func (c *Contact) CreateContact(in CreateContactInput) (out CreateContactOutput, err error) {
+	if c.Config.APIToken != "" {
+		return c.createContactV3(in)
+	}
 	err = c.SendRequest("POST", "/api/2.0/notification_contacts", &in, &out)
 	return
 }
@@ -193,11 +221,63 @@ type ModifyContactOutput struct {
 }
 
 // ModifyContact modifies an existing contact.
+//
+// When using an API token, the notification targets of the contact are
+// replaced with the ones derived from the supplied configuration. If neither
+// Email nor CellPhone is set, the targets are left unchanged.
 func (c *Contact) ModifyContact(in ModifyContactInput) (out ModifyContactOutput, err error) {
+	if c.Config.APIToken != "" {
+		err = c.SendJSONRequest("PUT", fmt.Sprintf("%s/%d", contactsTokenURI, in.ContactID), newContactInputV3(in.ContactConfiguration), &out)
+		return
+	}
 	err = c.SendRequest("PUT", fmt.Sprintf("/api/2.0/notification_contacts/%d", in.ContactID), &in, &out)
 	return
 }
 
+// ModifyContactsInput contains the input for the ModifyContacts function.
+type ModifyContactsInput struct {
+	_ struct{}
+
+	// The IDs of the contacts to modify. At least one ID is required.
+	ContactIDs []int `url:"contactids,comma"`
+
+	// Set to true to pause the contacts, or false to resume them. Left
+	// unset, the paused state of the contacts is not changed.
+	Paused *bool `url:"paused,omitempty"`
+
+	// The default SMS provider. One of: clickatell, bulksms, esendex,
+	// or cellsynt.
+	DefaultSMSProvider string `url:"defaultsmsprovider,omitempty"`
+}
+
+// errNoContactIDs is returned by ModifyContacts when no contact IDs are
+// given.
+var errNoContactIDs = errors.New("At least one contact ID is required to modify contacts")
+
+// ModifyContactsOutput contains the output for the ModifyContacts function.
+type ModifyContactsOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyContacts modifies several contacts at once. Only the paused state
+// and default SMS provider can be changed this way. An error is returned
+// without contacting Pingdom if ContactIDs is empty.
+func (c *Contact) ModifyContacts(in ModifyContactsInput) (out ModifyContactsOutput, err error) {
+	if len(in.ContactIDs) == 0 {
+		err = errNoContactIDs
+		return
+	}
+	if c.Config.APIToken != "" {
+		err = errBulkNotSupported
+		return
+	}
+	err = c.SendRequest("PUT", "/api/2.0/notification_contacts", &in, &out)
+	return
+}
+
 // DeleteContactInput contains the input for the DeleteContact method.
 type DeleteContactInput struct {
 	_ struct{}
@@ -216,6 +296,164 @@ type DeleteContactOutput struct {
 
 // DeleteContact deletes an existing contact from Pingdom.
 func (c *Contact) DeleteContact(in DeleteContactInput) (out DeleteContactOutput, err error) {
+	if c.Config.APIToken != "" {
+		err = c.SendJSONRequest("DELETE", fmt.Sprintf("%s/%d", contactsTokenURI, in.ContactID), nil, &out)
+		return
+	}
 	err = c.SendRequest("DELETE", fmt.Sprintf("/api/2.0/notification_contacts/%d", in.ContactID), nil, &out)
 	return
 }
+
+// DeleteContactsInput contains the input for the DeleteContacts method.
+type DeleteContactsInput struct {
+	_ struct{}
+
+	// The IDs of the contacts that you want to delete. At least one ID is
+	// required. Note that the API parameter is named delcheckids, even
+	// though it takes contact IDs.
+	ContactIDs []int `url:"delcheckids,comma"`
+}
+
+// errNoDeleteContactIDs is returned by DeleteContacts when no contact IDs
+// are given.
+var errNoDeleteContactIDs = errors.New("At least one contact ID is required to delete contacts")
+
+// DeleteContactsOutput contains the output for the DeleteContacts method.
+type DeleteContactsOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteContacts deletes several contacts from Pingdom at once.
+//
+// The API only reports overall success - the outcome of each individual
+// contact is not returned. Use GetContactList afterwards if you need to
+// confirm which contacts are gone. An error is returned without contacting
+// Pingdom if ContactIDs is empty.
+func (c *Contact) DeleteContacts(in DeleteContactsInput) (out DeleteContactsOutput, err error) {
+	if len(in.ContactIDs) == 0 {
+		err = errNoDeleteContactIDs
+		return
+	}
+	if c.Config.APIToken != "" {
+		err = errBulkNotSupported
+		return
+	}
+	err = c.SendRequest("DELETE", "/api/2.0/notification_contacts", &in, &out)
+	return
+}
+
+// contactsTokenURI is the version 3.1 equivalent of the notification contacts
+// endpoint. The client does not rewrite this one, as the request and response
+// data differ between versions.
+const contactsTokenURI = "/api/3.1/alerting/contacts"
+
+// errBulkNotSupported is returned by the bulk functions when using an API
+// token.
+var errBulkNotSupported = errors.New("Bulk contact changes are not supported by version 3.1 of the Pingdom API")
+
+// emailTargetV3 is a version 3.1 email notification target.
+type emailTargetV3 struct {
+	Severity string `json:"severity"`
+	Address  string `json:"address"`
+}
+
+// smsTargetV3 is a version 3.1 SMS notification target.
+type smsTargetV3 struct {
+	Severity    string `json:"severity"`
+	CountryCode string `json:"country_code"`
+	Number      string `json:"number"`
+	Provider    string `json:"provider,omitempty"`
+}
+
+// notificationTargetsV3 holds the notification targets of a version 3.1
+// contact.
+type notificationTargetsV3 struct {
+	Email []emailTargetV3 `json:"email,omitempty"`
+	SMS   []smsTargetV3   `json:"sms,omitempty"`
+}
+
+// contactInputV3 is the body of version 3.1 create and modify requests.
+// NotificationTargets is only sent when the configuration has targets, as
+// sending an empty set removes all targets from the contact.
+type contactInputV3 struct {
+	Name                string                 `json:"name,omitempty"`
+	NotificationTargets *notificationTargetsV3 `json:"notification_targets,omitempty"`
+}
+
+// contactEntryV3 holds a single contact from a version 3.1 contact list.
+type contactEntryV3 struct {
+	ID                  int
+	Name                string
+	Paused              bool
+	NotificationTargets notificationTargetsV3 `json:"notification_targets"`
+}
+
+// getContactListOutputV3 is the version 3.1 contact list.
+type getContactListOutputV3 struct {
+	Contacts []contactEntryV3
+}
+
+// newContactInputV3 translates a ContactConfiguration into a version 3.1
+// request body. Alerts to both targets are sent at high severity.
+func newContactInputV3(in ContactConfiguration) contactInputV3 {
+	v := contactInputV3{Name: in.Name}
+	if in.Email == "" && in.CellPhone == "" {
+		return v
+	}
+	v.NotificationTargets = &notificationTargetsV3{}
+	if in.Email != "" {
+		v.NotificationTargets.Email = []emailTargetV3{
+			{Severity: "HIGH", Address: in.Email},
+		}
+	}
+	if in.CellPhone != "" {
+		v.NotificationTargets.SMS = []smsTargetV3{
+			{Severity: "HIGH", CountryCode: in.CountryCode, Number: in.CellPhone, Provider: in.DefaultSMSProvider},
+		}
+	}
+	return v
+}
+
+// listEntry translates a version 3.1 contact into a ContactListEntry, using
+// the first email and SMS target of the contact.
+func (e contactEntryV3) listEntry() ContactListEntry {
+	v := ContactListEntry{
+		ID:     e.ID,
+		Name:   e.Name,
+		Paused: e.Paused,
+	}
+	if len(e.NotificationTargets.Email) > 0 {
+		v.Email = e.NotificationTargets.Email[0].Address
+	}
+	if len(e.NotificationTargets.SMS) > 0 {
+		v.CellPhone = e.NotificationTargets.SMS[0].Number
+		v.DefaultSMSProvider = e.NotificationTargets.SMS[0].Provider
+	}
+	return v
+}
+
+// getContactListV3 is GetContactList for version 3.1 of the API.
+func (c *Contact) getContactListV3() (out GetContactListOutput, err error) {
+	var v getContactListOutputV3
+	err = c.SendJSONRequest("GET", contactsTokenURI, nil, &v)
+	if err != nil {
+		return
+	}
+	for _, e := range v.Contacts {
+		out.Contacts = append(out.Contacts, e.listEntry())
+	}
+	return
+}
+
+// createContactV3 is CreateContact for version 3.1 of the API, which only
+// returns the ID of the new contact.
+func (c *Contact) createContactV3(in CreateContactInput) (out CreateContactOutput, err error) {
+	err = c.SendJSONRequest("POST", contactsTokenURI, newContactInputV3(in.ContactConfiguration), &out)
+	if err == nil {
+		out.Contact.Name = in.Name
+	}
+	return
+}
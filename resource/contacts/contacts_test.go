@@ -15,6 +15,7 @@
 package contacts
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"github.com/google/go-querystring/query"
 	"github.com/paybyphone/pingdom-go-sdk/integration"
 	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/resource/reference"
 )
 
 const errorResponseText = `
@@ -55,12 +57,14 @@ func setPingdomenv() {
 	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
 	os.Setenv("PINGDOM_PASSWORD", "changeit")
 	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func unsetPingdomenv() {
 	os.Unsetenv("PINGDOM_EMAIL_ADDRESS")
 	os.Unsetenv("PINGDOM_PASSWORD")
 	os.Unsetenv("PINGDOM_APP_KEY")
+	os.Unsetenv("PINGDOM_API_TOKEN")
 }
 
 func pingdomConfig() pingdom.Config {
@@ -163,6 +167,19 @@ func contactConfigurationData() ContactConfiguration {
 	}
 }
 
+func referenceData() reference.GetReferenceOutput {
+	return reference.GetReferenceOutput{
+		Countries: []reference.CountryEntry{
+			reference.CountryEntry{ID: 205, ISO: "SE"},
+			reference.CountryEntry{ID: 229, ISO: "US"},
+		},
+		PhoneCodes: []reference.PhoneCodeEntry{
+			reference.PhoneCodeEntry{CountryID: 205, PhoneCode: "46"},
+			reference.PhoneCodeEntry{CountryID: 229, PhoneCode: "1"},
+		},
+	}
+}
+
 func createContactInputData() CreateContactInput {
 	return CreateContactInput{
 		ContactConfiguration: contactConfigurationData(),
@@ -221,6 +238,46 @@ func httpModifyContactTestServer() *httptest.Server {
 	})
 }
 
+func modifyContactsInputData() ModifyContactsInput {
+	paused := true
+	return ModifyContactsInput{
+		ContactIDs:         []int{134536, 134537},
+		Paused:             &paused,
+		DefaultSMSProvider: "esendex",
+	}
+}
+
+const modifyContactsInputText = "contactids=134536%2C134537&defaultsmsprovider=esendex&paused=true"
+
+func modifyContactsResumeInputData() ModifyContactsInput {
+	paused := false
+	return ModifyContactsInput{
+		ContactIDs: []int{134536},
+		Paused:     &paused,
+	}
+}
+
+const modifyContactsResumeInputText = "contactids=134536&paused=false"
+
+func modifyContactsOutputData() ModifyContactsOutput {
+	return ModifyContactsOutput{
+		Message: "Modification of notification contacts was successful!",
+	}
+}
+
+const modifyContactsOutputText = `
+{
+	"message": "Modification of notification contacts was successful!"
+}
+`
+
+func httpModifyContactsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyContactsOutputText, http.StatusOK)
+	})
+}
+
 func deleteContactInputData() DeleteContactInput {
 	return DeleteContactInput{
 		ContactID: 134536,
@@ -246,6 +303,109 @@ func httpDeleteContactTestServer() *httptest.Server {
 	})
 }
 
+func deleteContactsInputData() DeleteContactsInput {
+	return DeleteContactsInput{
+		ContactIDs: []int{134536, 134537},
+	}
+}
+
+const deleteContactsInputText = "delcheckids=134536%2C134537"
+
+func deleteContactsOutputData() DeleteContactsOutput {
+	return DeleteContactsOutput{
+		Message: "Deletion of notification contacts was successful!",
+	}
+}
+
+const deleteContactsOutputText = `
+{
+	"message": "Deletion of notification contacts was successful!"
+}
+`
+
+func httpDeleteContactsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteContactsOutputText, http.StatusOK)
+	})
+}
+
+func pingdomTokenConfig() pingdom.Config {
+	c := pingdomConfig()
+	c.APIToken = "abcdefgh0123456789token"
+	return c
+}
+
+const getContactListTokenOutputText = `
+{
+	"contacts": [{
+		"id": 111250,
+		"name": "John Doe",
+		"paused": true,
+		"type": "user",
+		"owner": false,
+		"notification_targets": {
+			"email": [{
+				"severity": "HIGH",
+				"address": "john@johnsdomain.com"
+			}],
+			"sms": [{
+				"severity": "HIGH",
+				"country_code": "46",
+				"number": "5555555",
+				"provider": "esendex"
+			}]
+		}
+	}]
+}
+`
+
+func getContactListTokenOutputData() GetContactListOutput {
+	return GetContactListOutput{
+		Contacts: []ContactListEntry{
+			ContactListEntry{
+				ID:                 111250,
+				Name:               "John Doe",
+				Email:              "john@johnsdomain.com",
+				CellPhone:          "5555555",
+				DefaultSMSProvider: "esendex",
+				Paused:             true,
+			},
+		},
+	}
+}
+
+const contactConfigurationTokenText = `{"name":"John Doe","notification_targets":{"email":[{"severity":"HIGH","address":"john@johnsdomain.com"}],"sms":[{"severity":"HIGH","country_code":"46","number":"5555555","provider":"clickatell"}]}}`
+
+const contactNameTokenText = `{"name":"John Doe"}`
+
+// httpTokenTestServer serves the version 3.1 alerting contacts endpoints,
+// and fails any request that is not translated as expected.
+func httpTokenTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var resp string
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/3.1/alerting/contacts":
+			resp = getContactListTokenOutputText
+		case r.Method == "POST" && r.URL.Path == "/api/3.1/alerting/contacts" && string(body) == contactConfigurationTokenText:
+			resp = `{"contact": {"id": 111250}}`
+		case r.Method == "PUT" && r.URL.Path == "/api/3.1/alerting/contacts/134536" && string(body) == contactConfigurationTokenText:
+			resp = modifyContactOutputText
+		case r.Method == "PUT" && r.URL.Path == "/api/3.1/alerting/contacts/134537" && string(body) == contactNameTokenText:
+			resp = modifyContactOutputText
+		case r.Method == "DELETE" && r.URL.Path == "/api/3.1/alerting/contacts/134536":
+			resp = deleteContactOutputText
+		}
+		if resp == "" || r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, resp, http.StatusOK)
+	})
+}
+
 func TestContactNewWithEnv(t *testing.T) {
 	setPingdomenv()
 	c := New()
@@ -342,6 +502,45 @@ func TestContactConfigurationQueryText(t *testing.T) {
 	}
 }
 
+func TestContactConfigurationValidate(t *testing.T) {
+	ref := referenceData()
+
+	in := contactConfigurationData()
+	if err := in.Validate(ref); err != nil {
+		t.Fatalf("Unexpected validation error: %s", err)
+	}
+
+	in = ContactConfiguration{Name: "John Doe", Email: "john@johnsdomain.com"}
+	if err := in.Validate(ref); err != nil {
+		t.Fatalf("Unexpected validation error without phone: %s", err)
+	}
+
+	in = contactConfigurationData()
+	in.CountryCode = "1"
+	if err := in.Validate(ref); err == nil || err.Error() != "Phone code 1 does not belong to country SE" {
+		t.Fatalf("Expected phone code mismatch error, got %v", err)
+	}
+
+	in = contactConfigurationData()
+	in.CountryISO = "XX"
+	if err := in.Validate(ref); err == nil || err.Error() != "Country ISO code XX is not known to Pingdom" {
+		t.Fatalf("Expected country ISO validation error, got %v", err)
+	}
+
+	in = contactConfigurationData()
+	in.CountryCode = ""
+	if err := in.Validate(ref); err == nil || err.Error() != "Phone code is required with country ISO code SE" {
+		t.Fatalf("Expected missing phone code error, got %v", err)
+	}
+
+	in = contactConfigurationData()
+	in.CountryCode = ""
+	in.CountryISO = ""
+	if err := in.Validate(ref); err == nil || err.Error() != "Country code and country ISO code are required with cell phone 5555555" {
+		t.Fatalf("Expected missing country error, got %v", err)
+	}
+}
+
 func TestCreateContact(t *testing.T) {
 	ts := httpCreateContactTestServer()
 	defer ts.Close()
@@ -422,6 +621,83 @@ func TestModifyContactError(t *testing.T) {
 	}
 }
 
+func TestModifyContactsQueryText(t *testing.T) {
+	in := modifyContactsInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyContactsInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyContactsResumeQueryText(t *testing.T) {
+	in := modifyContactsResumeInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyContactsResumeInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyContacts(t *testing.T) {
+	ts := httpModifyContactsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyContactsInputData()
+	out, err := c.ModifyContacts(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyContactsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyContactsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyContactsInputData()
+	_, err := c.ModifyContacts(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyContactsNoContactIDs(t *testing.T) {
+	ts := httpModifyContactsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyContactsInputData()
+	in.ContactIDs = []int{}
+	_, err := c.ModifyContacts(in)
+
+	if err != errNoContactIDs {
+		t.Fatalf("expected %v, got %v", errNoContactIDs, err)
+	}
+}
+
 func TestDeleteContact(t *testing.T) {
 	ts := httpDeleteContactTestServer()
 	defer ts.Close()
@@ -462,6 +738,190 @@ func TestDeleteContactError(t *testing.T) {
 	}
 }
 
+func TestDeleteContactsQueryText(t *testing.T) {
+	in := deleteContactsInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := deleteContactsInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestDeleteContacts(t *testing.T) {
+	ts := httpDeleteContactsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteContactsInputData()
+	out, err := c.DeleteContacts(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteContactsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteContactsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteContactsInputData()
+	_, err := c.DeleteContacts(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteContactsNoContactIDs(t *testing.T) {
+	ts := httpDeleteContactsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteContactsInputData()
+	in.ContactIDs = []int{}
+	_, err := c.DeleteContacts(in)
+
+	if err != errNoDeleteContactIDs {
+		t.Fatalf("expected %v, got %v", errNoDeleteContactIDs, err)
+	}
+}
+
+func TestGetContactListToken(t *testing.T) {
+	ts := httpTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomTokenConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getContactListInputData()
+	out, err := c.GetContactList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getContactListTokenOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateContactToken(t *testing.T) {
+	ts := httpTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomTokenConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createContactInputData()
+	out, err := c.CreateContact(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createContactOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyContactToken(t *testing.T) {
+	ts := httpTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomTokenConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyContactInputData()
+	in.ContactID = 134536
+	out, err := c.ModifyContact(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyContactOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyContactNameOnlyToken(t *testing.T) {
+	ts := httpTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomTokenConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := ModifyContactInput{
+		ContactID: 134537,
+		ContactConfiguration: ContactConfiguration{
+			Name: "John Doe",
+		},
+	}
+	out, err := c.ModifyContact(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyContactOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteContactToken(t *testing.T) {
+	ts := httpTokenTestServer()
+	defer ts.Close()
+	cfg := pingdomTokenConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteContactInputData()
+	out, err := c.DeleteContact(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteContactOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestBulkContactsTokenError(t *testing.T) {
+	c := New(pingdomTokenConfig())
+	expected := "Bulk contact changes are not supported by version 3.1 of the Pingdom API"
+
+	if _, err := c.ModifyContacts(modifyContactsInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected ModifyContacts to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.DeleteContacts(deleteContactsInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected DeleteContacts to fail with %s, got %v", expected, err)
+	}
+}
+
 // testAccContactsCRUDCreate runs the Create section of the CRUD test
 // (using CreateContact).
 func testAccContactsCRUDCreate(t *testing.T, in CreateContactInput) int {
@@ -0,0 +1,107 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credits contains the methods necessary for retrieving the check
+// and SMS credits available to a Pingdom account.
+package credits
+
+import (
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Credit is the base client for credit-related methods.
+type Credit struct {
+	client.Client
+}
+
+// New returns a new instance of the Credit API.
+func New(configs ...pingdom.Config) *Credit {
+	c := &Credit{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// CreditsEntry contains the credit data returned by GetCredits.
+type CreditsEntry struct {
+	_ struct{}
+
+	// The total number of checks allowed on the account.
+	CheckLimit int
+
+	// The number of checks that can still be created.
+	AvailableChecks int
+
+	// The number of uptime checks in use.
+	UsedDefault int
+
+	// The number of transaction checks in use.
+	UsedTransaction int
+
+	// The number of SMS credits remaining.
+	AvailableSMS int
+
+	// The number of SMS test credits remaining.
+	AvailableSMSTests int
+
+	// true if SMS credits are automatically refilled.
+	AutoFillSMS bool
+
+	// The number of SMS credits added on each automatic refill.
+	AutoFillSMSAmount int `json:"autofillsms_amount"`
+
+	// The SMS credit level that triggers an automatic refill.
+	AutoFillSMSWhenLeft int `json:"autofillsms_when_left"`
+
+	// The number of SMS that can be sent beyond the remaining credits.
+	MaxSMSOverage int `json:"max_sms_overage"`
+
+	// The number of RUM sites that can still be created.
+	AvailableRUMSites int
+
+	// The number of RUM sites in use.
+	UsedRUMSites int
+
+	// The maximum number of RUM filters.
+	MaxRUMFilters int
+
+	// The maximum number of RUM page views per month.
+	MaxRUMPageViews int
+}
+
+// CanCreateChecks returns true if the account has room for n more checks.
+func (e CreditsEntry) CanCreateChecks(n int) bool {
+	return e.AvailableChecks >= n
+}
+
+// GetCreditsInput contains the input to send to the GetCredits function. The
+// credits endpoint takes no parameters.
+type GetCreditsInput struct {
+	_ struct{}
+}
+
+// GetCreditsOutput contains the output for the GetCredits function.
+type GetCreditsOutput struct {
+	_ struct{}
+
+	// The credit data.
+	Credits CreditsEntry
+}
+
+// GetCredits gets the check, SMS, and RUM credits for the Pingdom account.
+func (c *Credit) GetCredits(in GetCreditsInput) (out GetCreditsOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/credits", &in, &out)
+	return
+}
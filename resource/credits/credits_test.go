@@ -0,0 +1,214 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credits
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/paybyphone/pingdom-go-sdk/integration"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getCreditsInputData() GetCreditsInput {
+	return GetCreditsInput{}
+}
+
+func getCreditsOutputData() GetCreditsOutput {
+	return GetCreditsOutput{
+		Credits: CreditsEntry{
+			CheckLimit:          50,
+			AvailableChecks:     12,
+			UsedDefault:         35,
+			UsedTransaction:     3,
+			AvailableSMS:        100,
+			AvailableSMSTests:   10,
+			AutoFillSMS:         true,
+			AutoFillSMSAmount:   50,
+			AutoFillSMSWhenLeft: 10,
+			MaxSMSOverage:       20,
+			AvailableRUMSites:   2,
+			UsedRUMSites:        1,
+			MaxRUMFilters:       10,
+			MaxRUMPageViews:     100000,
+		},
+	}
+}
+
+const getCreditsOutputText = `
+{
+	"credits": {
+		"checklimit": 50,
+		"availablechecks": 12,
+		"useddefault": 35,
+		"usedtransaction": 3,
+		"availablesms": 100,
+		"availablesmstests": 10,
+		"autofillsms": true,
+		"autofillsms_amount": 50,
+		"autofillsms_when_left": 10,
+		"max_sms_overage": 20,
+		"availablerumsites": 2,
+		"usedrumsites": 1,
+		"maxrumfilters": 10,
+		"maxrumpageviews": 100000
+	}
+}
+`
+
+func httpGetCreditsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getCreditsOutputText, http.StatusOK)
+	})
+}
+
+func TestCreditNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestCreditNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetCredits(t *testing.T) {
+	ts := httpGetCreditsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getCreditsInputData()
+	out, err := c.GetCredits(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getCreditsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetCreditsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getCreditsInputData()
+	_, err := c.GetCredits(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestCreditsEntryCanCreateChecks(t *testing.T) {
+	e := getCreditsOutputData().Credits
+
+	if e.CanCreateChecks(12) == false {
+		t.Fatalf("Expected to be able to create 12 checks")
+	}
+	if e.CanCreateChecks(13) == true {
+		t.Fatalf("Expected to not be able to create 13 checks")
+	}
+}
+
+// TestAccCredits runs a basic read test against the Pingdom account credits.
+func TestAccCredits(t *testing.T) {
+	testacc.VetAccConditions(t)
+
+	c := New()
+	out, err := c.GetCredits(GetCreditsInput{})
+	if err != nil {
+		t.Fatalf("Error reading credits: %v", err)
+	}
+	if out.Credits.CheckLimit == 0 {
+		t.Fatalf("Expected out.Credits.CheckLimit to be non-zero")
+	}
+}
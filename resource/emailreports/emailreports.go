@@ -0,0 +1,205 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package emailreports contains the methods necessary for managing scheduled
+// email report subscriptions at Pingdom.
+//
+// Email reports can only be managed with an email address, password, and
+// application key. All functions return an error when an API token is
+// configured.
+package emailreports
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// EmailReport is the base client for email report-related methods.
+type EmailReport struct {
+	client.Client
+}
+
+// New returns a new instance of the EmailReport API.
+func New(configs ...pingdom.Config) *EmailReport {
+	c := &EmailReport{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all email report functions when using
+// an API token, as version 3.1 of the API has no email reports endpoint.
+var errTokenNotSupported = errors.New("Email reports cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *EmailReport) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// EmailReportListEntry holds a single subscription from
+// GetEmailReportListOutput.
+type EmailReportListEntry struct {
+	_ struct{}
+
+	// The subscription identifier.
+	ID int
+
+	// The subscription name.
+	Name string
+
+	// The ID of the check the report covers. Not set for reports that cover
+	// the whole account.
+	CheckID int
+
+	// How often the report is sent. One of monthly, weekly, or daily.
+	Frequency string
+
+	// The contact IDs the report is sent to.
+	ContactIDs []int
+
+	// Additional email addresses the report is sent to.
+	AdditionalEmails []string
+
+	// The report type.
+	Type string
+}
+
+// GetEmailReportListInput contains the input to send to the
+// GetEmailReportList function. The email report list endpoint takes no
+// parameters.
+type GetEmailReportListInput struct {
+	_ struct{}
+}
+
+// GetEmailReportListOutput contains the output for the GetEmailReportList
+// function.
+type GetEmailReportListOutput struct {
+	_ struct{}
+
+	// The list of subscriptions.
+	Subscriptions []EmailReportListEntry
+}
+
+// GetEmailReportList gets a list of all email report subscriptions.
+func (c *EmailReport) GetEmailReportList(in GetEmailReportListInput) (out GetEmailReportListOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.0/reports.email", &in, &out)
+	return
+}
+
+// EmailReportConfiguration is the structure for the CreateEmailReport and
+// ModifyEmailReport functions.
+type EmailReportConfiguration struct {
+	_ struct{}
+
+	// The subscription name.
+	Name string `url:"name,omitempty"`
+
+	// The ID of the check to report on. Leave unset to report on the whole
+	// account.
+	CheckID int `url:"checkid,omitempty"`
+
+	// How often the report is sent. One of monthly, weekly, or daily.
+	Frequency string `url:"frequency,omitempty"`
+
+	// The contact IDs to send the report to.
+	ContactIDs []int `url:"contactids,comma,omitempty"`
+
+	// Additional email addresses to send the report to.
+	AdditionalEmails []string `url:"additionalemails,comma,omitempty"`
+
+	// The report type. One of uptime, response, or full.
+	Type string `url:"type,omitempty"`
+}
+
+// CreateEmailReportInput contains the input for the CreateEmailReport
+// function.
+type CreateEmailReportInput struct {
+	_ struct{}
+
+	EmailReportConfiguration
+}
+
+// CreateEmailReportOutput contains the output for the CreateEmailReport
+// function.
+type CreateEmailReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// CreateEmailReport creates an email report subscription. Note that the API
+// does not return the ID of the new subscription - use GetEmailReportList to
+// look it up by name.
+func (c *EmailReport) CreateEmailReport(in CreateEmailReportInput) (out CreateEmailReportOutput, err error) {
+	err = c.sendRequest("POST", "/api/2.0/reports.email", &in, &out)
+	return
+}
+
+// ModifyEmailReportInput contains the input for the ModifyEmailReport
+// function.
+type ModifyEmailReportInput struct {
+	_ struct{}
+
+	// The ID of the subscription to modify.
+	ReportID int `url:"-"`
+
+	EmailReportConfiguration
+}
+
+// ModifyEmailReportOutput contains the output for the ModifyEmailReport
+// function.
+type ModifyEmailReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyEmailReport modifies an existing email report subscription.
+func (c *EmailReport) ModifyEmailReport(in ModifyEmailReportInput) (out ModifyEmailReportOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.0/reports.email/%d", in.ReportID), &in, &out)
+	return
+}
+
+// DeleteEmailReportInput contains the input for the DeleteEmailReport
+// function.
+type DeleteEmailReportInput struct {
+	_ struct{}
+
+	// The ID of the subscription that you want to delete.
+	ReportID int
+}
+
+// DeleteEmailReportOutput contains the output for the DeleteEmailReport
+// function.
+type DeleteEmailReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteEmailReport deletes an existing email report subscription.
+func (c *EmailReport) DeleteEmailReport(in DeleteEmailReportInput) (out DeleteEmailReportOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.0/reports.email/%d", in.ReportID), nil, &out)
+	return
+}
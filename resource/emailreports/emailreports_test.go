@@ -0,0 +1,414 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emailreports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getEmailReportListInputData() GetEmailReportListInput {
+	return GetEmailReportListInput{}
+}
+
+func getEmailReportListOutputData() GetEmailReportListOutput {
+	return GetEmailReportListOutput{
+		Subscriptions: []EmailReportListEntry{
+			EmailReportListEntry{
+				ID:               12345,
+				Name:             "Weekly uptime digest",
+				CheckID:          85975,
+				Frequency:        "weekly",
+				ContactIDs:       []int{111250, 111251},
+				AdditionalEmails: []string{"ops@example.com"},
+				Type:             "uptime",
+			},
+		},
+	}
+}
+
+const getEmailReportListOutputText = `
+{
+	"subscriptions": [{
+		"id": 12345,
+		"name": "Weekly uptime digest",
+		"checkid": 85975,
+		"frequency": "weekly",
+		"contactids": [111250, 111251],
+		"additionalemails": ["ops@example.com"],
+		"type": "uptime"
+	}]
+}
+`
+
+func httpGetEmailReportListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getEmailReportListOutputText, http.StatusOK)
+	})
+}
+
+func emailReportConfigurationData() EmailReportConfiguration {
+	return EmailReportConfiguration{
+		Name:             "Weekly uptime digest",
+		CheckID:          85975,
+		Frequency:        "weekly",
+		ContactIDs:       []int{111250, 111251},
+		AdditionalEmails: []string{"ops@example.com", "dev@example.com"},
+		Type:             "uptime",
+	}
+}
+
+const emailReportConfigurationText = "additionalemails=ops%40example.com%2Cdev%40example.com&checkid=85975&contactids=111250%2C111251&frequency=weekly&name=Weekly+uptime+digest&type=uptime"
+
+func createEmailReportInputData() CreateEmailReportInput {
+	return CreateEmailReportInput{
+		EmailReportConfiguration: emailReportConfigurationData(),
+	}
+}
+
+func createEmailReportOutputData() CreateEmailReportOutput {
+	return CreateEmailReportOutput{
+		Message: "Subscription added",
+	}
+}
+
+const createEmailReportOutputText = `
+{
+	"message": "Subscription added"
+}
+`
+
+func httpCreateEmailReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createEmailReportOutputText, http.StatusOK)
+	})
+}
+
+func modifyEmailReportInputData() ModifyEmailReportInput {
+	return ModifyEmailReportInput{
+		ReportID:                 12345,
+		EmailReportConfiguration: emailReportConfigurationData(),
+	}
+}
+
+func modifyEmailReportOutputData() ModifyEmailReportOutput {
+	return ModifyEmailReportOutput{
+		Message: "Subscription updated",
+	}
+}
+
+const modifyEmailReportOutputText = `
+{
+	"message": "Subscription updated"
+}
+`
+
+func httpModifyEmailReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyEmailReportOutputText, http.StatusOK)
+	})
+}
+
+func deleteEmailReportInputData() DeleteEmailReportInput {
+	return DeleteEmailReportInput{
+		ReportID: 12345,
+	}
+}
+
+func deleteEmailReportOutputData() DeleteEmailReportOutput {
+	return DeleteEmailReportOutput{
+		Message: "Subscription deleted",
+	}
+}
+
+const deleteEmailReportOutputText = `
+{
+	"message": "Subscription deleted"
+}
+`
+
+func httpDeleteEmailReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteEmailReportOutputText, http.StatusOK)
+	})
+}
+
+func TestEmailReportNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestEmailReportNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetEmailReportList(t *testing.T) {
+	ts := httpGetEmailReportListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getEmailReportListInputData()
+	out, err := c.GetEmailReportList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getEmailReportListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetEmailReportListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getEmailReportListInputData()
+	_, err := c.GetEmailReportList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestEmailReportConfigurationQueryText(t *testing.T) {
+	in := createEmailReportInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := emailReportConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateEmailReport(t *testing.T) {
+	ts := httpCreateEmailReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createEmailReportInputData()
+	out, err := c.CreateEmailReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createEmailReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateEmailReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createEmailReportInputData()
+	_, err := c.CreateEmailReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyEmailReport(t *testing.T) {
+	ts := httpModifyEmailReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyEmailReportInputData()
+	out, err := c.ModifyEmailReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyEmailReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyEmailReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyEmailReportInputData()
+	_, err := c.ModifyEmailReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteEmailReport(t *testing.T) {
+	ts := httpDeleteEmailReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteEmailReportInputData()
+	out, err := c.DeleteEmailReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteEmailReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteEmailReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteEmailReportInputData()
+	_, err := c.DeleteEmailReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestEmailReportsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Email reports cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetEmailReportList(getEmailReportListInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetEmailReportList to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.CreateEmailReport(createEmailReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected CreateEmailReport to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.DeleteEmailReport(deleteEmailReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected DeleteEmailReport to fail with %s, got %v", expected, err)
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package maintenance contains the methods necessary for managing
+// maintenance windows at Pingdom.
+package maintenance
+
+import (
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Maintenance is the base client for maintenance-related methods.
+type Maintenance struct {
+	client.Client
+}
+
+// New returns a new instance of the Maintenance API.
+func New(configs ...pingdom.Config) *Maintenance {
+	c := &Maintenance{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// MaintenanceEntryChecks contains the checks that a maintenance window
+// applies to.
+type MaintenanceEntryChecks struct {
+	_ struct{}
+
+	// The IDs of the uptime checks.
+	Uptime []int
+
+	// The IDs of the transaction checks.
+	TMS []int
+}
+
+// MaintenanceEntry holds a single maintenance window, as returned by
+// GetMaintenanceList and GetDetailedMaintenance.
+type MaintenanceEntry struct {
+	_ struct{}
+
+	// The maintenance window identifier.
+	ID int
+
+	// The maintenance window description.
+	Description string
+
+	// The start time of the maintenance window (UNIX timestamp).
+	From int
+
+	// The end time of the maintenance window (UNIX timestamp).
+	To int
+
+	// The recurrence type. One of none, day, week, or month.
+	RecurrenceType string
+
+	// The recurrence interval, in units of RecurrenceType.
+	RepeatEvery int
+
+	// The time the recurrence ends (UNIX timestamp).
+	EffectiveTo int
+
+	// The checks affected by the maintenance window.
+	Checks MaintenanceEntryChecks
+}
+
+// GetMaintenanceListInput contains the input to send to the
+// GetMaintenanceList function.
+type GetMaintenanceListInput struct {
+	_ struct{}
+
+	// Limits the number of returned maintenance windows to the specified
+	// quantity.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset for the maintenance window listing. Requires Limit.
+	Offset int `url:"offset,omitempty"`
+
+	// The field to order the listing by. One of description, from, to,
+	// effectiveto, recurrencetype, or repeatevery.
+	OrderBy string `url:"orderby,omitempty"`
+
+	// The sort order. One of asc or desc.
+	Order string `url:"order,omitempty"`
+}
+
+// GetMaintenanceListOutput contains the output for the GetMaintenanceList
+// function.
+type GetMaintenanceListOutput struct {
+	_ struct{}
+
+	// The list of matched maintenance windows.
+	Maintenance []MaintenanceEntry
+}
+
+// GetMaintenanceList gets a list of maintenance windows based on a specific
+// set of filters.
+func (c *Maintenance) GetMaintenanceList(in GetMaintenanceListInput) (out GetMaintenanceListOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/maintenance", &in, &out)
+	return
+}
+
+// GetDetailedMaintenanceInput contains the input to send to the
+// GetDetailedMaintenance function.
+type GetDetailedMaintenanceInput struct {
+	_ struct{}
+
+	// The ID of the maintenance window to get details for.
+	MaintenanceID int
+}
+
+// GetDetailedMaintenanceOutput contains the output for the
+// GetDetailedMaintenance function.
+type GetDetailedMaintenanceOutput struct {
+	_ struct{}
+
+	// The maintenance window.
+	Maintenance MaintenanceEntry
+}
+
+// GetDetailedMaintenance gets detailed information about a single maintenance
+// window.
+func (c *Maintenance) GetDetailedMaintenance(in GetDetailedMaintenanceInput) (out GetDetailedMaintenanceOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/maintenance/%d", in.MaintenanceID), nil, &out)
+	return
+}
+
+// MaintenanceConfiguration is the structure for the CreateMaintenance and
+// ModifyMaintenance functions.
+type MaintenanceConfiguration struct {
+	_ struct{}
+
+	// The maintenance window description.
+	Description string `url:"description,omitempty"`
+
+	// The start time of the maintenance window (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// The end time of the maintenance window (UNIX timestamp).
+	To int `url:"to,omitempty"`
+
+	// The recurrence type. One of none, day, week, or month. Defaults to
+	// none.
+	RecurrenceType string `url:"recurrencetype,omitempty"`
+
+	// The recurrence interval, in units of RecurrenceType. For example, a
+	// RecurrenceType of week and a RepeatEvery of 2 repeats the maintenance
+	// window every two weeks.
+	RepeatEvery int `url:"repeatevery,omitempty"`
+
+	// The time the recurrence ends (UNIX timestamp). Required if
+	// RecurrenceType is not none.
+	EffectiveTo int `url:"effectiveto,omitempty"`
+
+	// The IDs of the uptime checks affected by the maintenance window.
+	UptimeIDs []int `url:"uptimeids,comma,omitempty"`
+
+	// The IDs of the transaction checks affected by the maintenance window.
+	TMSIDs []int `url:"tmsids,comma,omitempty"`
+}
+
+// CreateMaintenanceInput contains the input for the CreateMaintenance
+// function.
+type CreateMaintenanceInput struct {
+	_ struct{}
+
+	MaintenanceConfiguration
+}
+
+// CreateMaintenanceEntry is the actual maintenance window data in the
+// output of CreateMaintenance.
+type CreateMaintenanceEntry struct {
+	_ struct{}
+
+	// The ID of the maintenance window that was created.
+	ID int
+}
+
+// CreateMaintenanceOutput contains the output for the CreateMaintenance
+// function.
+type CreateMaintenanceOutput struct {
+	_ struct{}
+
+	// The maintenance window data.
+	Maintenance CreateMaintenanceEntry
+}
+
+// CreateMaintenance creates a maintenance window.
+func (c *Maintenance) CreateMaintenance(in CreateMaintenanceInput) (out CreateMaintenanceOutput, err error) {
+	err = c.SendRequest("POST", "/api/2.0/maintenance", &in, &out)
+	return
+}
+
+// ModifyMaintenanceInput contains the input for the ModifyMaintenance
+// function.
+type ModifyMaintenanceInput struct {
+	_ struct{}
+
+	// The ID of the maintenance window to modify.
+	MaintenanceID int `url:"-"`
+
+	// The replacement maintenance window configuration.
+	MaintenanceConfiguration
+}
+
+// ModifyMaintenanceOutput contains the output for the ModifyMaintenance
+// function.
+type ModifyMaintenanceOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyMaintenance modifies an existing maintenance window.
+func (c *Maintenance) ModifyMaintenance(in ModifyMaintenanceInput) (out ModifyMaintenanceOutput, err error) {
+	err = c.SendRequest("PUT", fmt.Sprintf("/api/2.0/maintenance/%d", in.MaintenanceID), &in, &out)
+	return
+}
+
+// DeleteMaintenanceInput contains the input for the DeleteMaintenance
+// function.
+type DeleteMaintenanceInput struct {
+	_ struct{}
+
+	// The ID of the maintenance window that you want to delete.
+	MaintenanceID int
+}
+
+// DeleteMaintenanceOutput contains the output for the DeleteMaintenance
+// function.
+type DeleteMaintenanceOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteMaintenance deletes a maintenance window from Pingdom.
+//
+// Note that only future maintenance windows can be deleted.
+func (c *Maintenance) DeleteMaintenance(in DeleteMaintenanceInput) (out DeleteMaintenanceOutput, err error) {
+	err = c.SendRequest("DELETE", fmt.Sprintf("/api/2.0/maintenance/%d", in.MaintenanceID), nil, &out)
+	return
+}
+
+// DeleteMaintenanceWindowsInput contains the input for the
+// DeleteMaintenanceWindows function.
+type DeleteMaintenanceWindowsInput struct {
+	_ struct{}
+
+	// The IDs of the maintenance windows that you want to delete.
+	MaintenanceIDs []int `url:"maintenanceids,comma"`
+}
+
+// DeleteMaintenanceWindowsOutput contains the output for the
+// DeleteMaintenanceWindows function.
+type DeleteMaintenanceWindowsOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteMaintenanceWindows deletes several maintenance windows from Pingdom
+// in a single request.
+func (c *Maintenance) DeleteMaintenanceWindows(in DeleteMaintenanceWindowsInput) (out DeleteMaintenanceWindowsOutput, err error) {
+	err = c.SendRequest("DELETE", "/api/2.0/maintenance", &in, &out)
+	return
+}
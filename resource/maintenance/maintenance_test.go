@@ -0,0 +1,650 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/integration"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getMaintenanceListInputData() GetMaintenanceListInput {
+	return GetMaintenanceListInput{
+		Limit:   10,
+		Offset:  5,
+		OrderBy: "from",
+		Order:   "asc",
+	}
+}
+
+const getMaintenanceListInputText = "limit=10&offset=5&order=asc&orderby=from"
+
+func maintenanceEntryData() MaintenanceEntry {
+	return MaintenanceEntry{
+		ID:             15,
+		Description:    "Weekly deploy",
+		From:           1496136600,
+		To:             1496138400,
+		RecurrenceType: "week",
+		RepeatEvery:    1,
+		EffectiveTo:    1527672600,
+		Checks: MaintenanceEntryChecks{
+			Uptime: []int{85975, 161748},
+			TMS:    []int{},
+		},
+	}
+}
+
+const maintenanceEntryText = `
+{
+	"id": 15,
+	"description": "Weekly deploy",
+	"from": 1496136600,
+	"to": 1496138400,
+	"recurrencetype": "week",
+	"repeatevery": 1,
+	"effectiveto": 1527672600,
+	"checks": {
+		"uptime": [85975, 161748],
+		"tms": []
+	}
+}
+`
+
+func getMaintenanceListOutputData() GetMaintenanceListOutput {
+	return GetMaintenanceListOutput{
+		Maintenance: []MaintenanceEntry{maintenanceEntryData()},
+	}
+}
+
+const getMaintenanceListOutputText = `{"maintenance": [` + maintenanceEntryText + `]}`
+
+func httpGetMaintenanceListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getMaintenanceListOutputText, http.StatusOK)
+	})
+}
+
+func getDetailedMaintenanceInputData() GetDetailedMaintenanceInput {
+	return GetDetailedMaintenanceInput{
+		MaintenanceID: 15,
+	}
+}
+
+func getDetailedMaintenanceOutputData() GetDetailedMaintenanceOutput {
+	return GetDetailedMaintenanceOutput{
+		Maintenance: maintenanceEntryData(),
+	}
+}
+
+const getDetailedMaintenanceOutputText = `{"maintenance": ` + maintenanceEntryText + `}`
+
+func httpGetDetailedMaintenanceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getDetailedMaintenanceOutputText, http.StatusOK)
+	})
+}
+
+func maintenanceConfigurationData() MaintenanceConfiguration {
+	return MaintenanceConfiguration{
+		Description:    "Weekly deploy",
+		From:           1496136600,
+		To:             1496138400,
+		RecurrenceType: "week",
+		RepeatEvery:    1,
+		EffectiveTo:    1527672600,
+		UptimeIDs:      []int{85975, 161748},
+		TMSIDs:         []int{1234},
+	}
+}
+
+const maintenanceConfigurationText = "description=Weekly+deploy&effectiveto=1527672600&from=1496136600&recurrencetype=week&repeatevery=1&tmsids=1234&to=1496138400&uptimeids=85975%2C161748"
+
+func createMaintenanceInputData() CreateMaintenanceInput {
+	return CreateMaintenanceInput{
+		MaintenanceConfiguration: maintenanceConfigurationData(),
+	}
+}
+
+func createMaintenanceOutputData() CreateMaintenanceOutput {
+	return CreateMaintenanceOutput{
+		Maintenance: CreateMaintenanceEntry{
+			ID: 15,
+		},
+	}
+}
+
+const createMaintenanceOutputText = `
+{
+	"maintenance": {
+		"id": 15
+	}
+}
+`
+
+func httpCreateMaintenanceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createMaintenanceOutputText, http.StatusOK)
+	})
+}
+
+func modifyMaintenanceInputData() ModifyMaintenanceInput {
+	return ModifyMaintenanceInput{
+		MaintenanceID:            15,
+		MaintenanceConfiguration: maintenanceConfigurationData(),
+	}
+}
+
+func modifyMaintenanceOutputData() ModifyMaintenanceOutput {
+	return ModifyMaintenanceOutput{
+		Message: "Maintenance window successfully modified!",
+	}
+}
+
+const modifyMaintenanceOutputText = `
+{
+	"message": "Maintenance window successfully modified!"
+}
+`
+
+func httpModifyMaintenanceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyMaintenanceOutputText, http.StatusOK)
+	})
+}
+
+func deleteMaintenanceInputData() DeleteMaintenanceInput {
+	return DeleteMaintenanceInput{
+		MaintenanceID: 15,
+	}
+}
+
+func deleteMaintenanceOutputData() DeleteMaintenanceOutput {
+	return DeleteMaintenanceOutput{
+		Message: "Maintenance window successfully deleted!",
+	}
+}
+
+const deleteMaintenanceOutputText = `
+{
+	"message": "Maintenance window successfully deleted!"
+}
+`
+
+func httpDeleteMaintenanceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteMaintenanceOutputText, http.StatusOK)
+	})
+}
+
+func deleteMaintenanceWindowsInputData() DeleteMaintenanceWindowsInput {
+	return DeleteMaintenanceWindowsInput{
+		MaintenanceIDs: []int{15, 16, 17},
+	}
+}
+
+const deleteMaintenanceWindowsInputText = "maintenanceids=15%2C16%2C17"
+
+func deleteMaintenanceWindowsOutputData() DeleteMaintenanceWindowsOutput {
+	return DeleteMaintenanceWindowsOutput{
+		Message: "3 maintenance windows successfully deleted.",
+	}
+}
+
+const deleteMaintenanceWindowsOutputText = `
+{
+	"message": "3 maintenance windows successfully deleted."
+}
+`
+
+func httpDeleteMaintenanceWindowsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteMaintenanceWindowsOutputText, http.StatusOK)
+	})
+}
+
+func TestMaintenanceNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestMaintenanceNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetMaintenanceListQueryText(t *testing.T) {
+	in := getMaintenanceListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getMaintenanceListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetMaintenanceList(t *testing.T) {
+	ts := httpGetMaintenanceListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getMaintenanceListInputData()
+	out, err := c.GetMaintenanceList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getMaintenanceListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetMaintenanceListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getMaintenanceListInputData()
+	_, err := c.GetMaintenanceList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetDetailedMaintenance(t *testing.T) {
+	ts := httpGetDetailedMaintenanceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedMaintenanceInputData()
+	out, err := c.GetDetailedMaintenance(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getDetailedMaintenanceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetDetailedMaintenanceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedMaintenanceInputData()
+	_, err := c.GetDetailedMaintenance(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestMaintenanceConfigurationQueryText(t *testing.T) {
+	in := createMaintenanceInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := maintenanceConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateMaintenance(t *testing.T) {
+	ts := httpCreateMaintenanceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createMaintenanceInputData()
+	out, err := c.CreateMaintenance(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createMaintenanceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateMaintenanceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createMaintenanceInputData()
+	_, err := c.CreateMaintenance(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyMaintenance(t *testing.T) {
+	ts := httpModifyMaintenanceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyMaintenanceInputData()
+	out, err := c.ModifyMaintenance(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyMaintenanceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyMaintenanceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyMaintenanceInputData()
+	_, err := c.ModifyMaintenance(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteMaintenance(t *testing.T) {
+	ts := httpDeleteMaintenanceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteMaintenanceInputData()
+	out, err := c.DeleteMaintenance(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteMaintenanceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteMaintenanceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteMaintenanceInputData()
+	_, err := c.DeleteMaintenance(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteMaintenanceWindowsQueryText(t *testing.T) {
+	in := deleteMaintenanceWindowsInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := deleteMaintenanceWindowsInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestDeleteMaintenanceWindows(t *testing.T) {
+	ts := httpDeleteMaintenanceWindowsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteMaintenanceWindowsInputData()
+	out, err := c.DeleteMaintenanceWindows(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteMaintenanceWindowsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteMaintenanceWindowsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteMaintenanceWindowsInputData()
+	_, err := c.DeleteMaintenanceWindows(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+// testAccMaintenanceCRUDConfiguration returns a maintenance window
+// configuration that starts one day from now, with no affected checks.
+func testAccMaintenanceCRUDConfiguration() MaintenanceConfiguration {
+	from := time.Now().Add(24 * time.Hour)
+	return MaintenanceConfiguration{
+		Description: "Acceptance test window",
+		From:        int(from.Unix()),
+		To:          int(from.Add(time.Hour).Unix()),
+	}
+}
+
+// testAccMaintenanceCRUDCreate runs the Create section of the CRUD test
+// (using CreateMaintenance).
+func testAccMaintenanceCRUDCreate(t *testing.T, in CreateMaintenanceInput) int {
+	c := New()
+	out, err := c.CreateMaintenance(in)
+	if err != nil {
+		t.Fatalf("Error creating maintenance window: %v", err)
+	}
+	if out.Maintenance.ID == 0 {
+		t.Fatalf("Error reading maintenance window ID from output (out.Maintenance.ID was empty)")
+	}
+	return out.Maintenance.ID
+}
+
+// testAccMaintenanceCRUDRead runs the Read section of the CRUD test
+// (using GetDetailedMaintenance).
+func testAccMaintenanceCRUDRead(t *testing.T, id int, description string) {
+	c := New()
+	out, err := c.GetDetailedMaintenance(GetDetailedMaintenanceInput{MaintenanceID: id})
+	if err != nil {
+		t.Fatalf("Error reading maintenance window: %v", err)
+	}
+	if out.Maintenance.Description != description {
+		t.Fatalf("Expected Description to be %s, got %v", description, out.Maintenance.Description)
+	}
+}
+
+// testAccMaintenanceCRUDUpdate runs the Update section of the CRUD test
+// (using ModifyMaintenance).
+func testAccMaintenanceCRUDUpdate(t *testing.T, id int, in ModifyMaintenanceInput) {
+	c := New()
+	in.Description = "Acceptance test window (updated)"
+	in.MaintenanceID = id
+	_, err := c.ModifyMaintenance(in)
+	if err != nil {
+		t.Fatalf("Error updating maintenance window: %v", err)
+	}
+
+	testAccMaintenanceCRUDRead(t, id, "Acceptance test window (updated)")
+}
+
+// testAccMaintenanceCRUDDelete runs the Delete section of the CRUD test
+// (using DeleteMaintenance).
+func testAccMaintenanceCRUDDelete(t *testing.T, id int) {
+	c := New()
+	out, err := c.DeleteMaintenance(DeleteMaintenanceInput{MaintenanceID: id})
+	if err != nil {
+		t.Fatalf("Error deleting maintenance window: %v", err)
+	}
+	if strings.Contains(out.Message, "deleted") == false {
+		t.Fatalf("Expected out.Message to report deletion, got %v", out.Message)
+	}
+}
+
+// TestAccMaintenanceCRUD runs a full create-read-update-delete test for a
+// Pingdom maintenance window.
+func TestAccMaintenanceCRUD(t *testing.T) {
+	testacc.VetAccConditions(t)
+
+	cfg := testAccMaintenanceCRUDConfiguration()
+	id := testAccMaintenanceCRUDCreate(t, CreateMaintenanceInput{MaintenanceConfiguration: cfg})
+	testAccMaintenanceCRUDRead(t, id, "Acceptance test window")
+	testAccMaintenanceCRUDUpdate(t, id, ModifyMaintenanceInput{MaintenanceConfiguration: cfg})
+	testAccMaintenanceCRUDDelete(t, id)
+}
@@ -0,0 +1,192 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package occurrences contains the methods necessary for managing the
+// individual occurrences of maintenance windows at Pingdom.
+package occurrences
+
+import (
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Occurrence is the base client for maintenance occurrence-related methods.
+type Occurrence struct {
+	client.Client
+}
+
+// New returns a new instance of the Occurrence API.
+func New(configs ...pingdom.Config) *Occurrence {
+	c := &Occurrence{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// OccurrenceEntry holds a single maintenance occurrence, as returned by
+// GetOccurrenceList and GetDetailedOccurrence.
+type OccurrenceEntry struct {
+	_ struct{}
+
+	// The occurrence identifier.
+	ID int
+
+	// The ID of the maintenance window this occurrence belongs to.
+	MaintenanceID int
+
+	// The start time of the occurrence (UNIX timestamp).
+	From int
+
+	// The end time of the occurrence (UNIX timestamp).
+	To int
+}
+
+// GetOccurrenceListInput contains the input to send to the GetOccurrenceList
+// function.
+type GetOccurrenceListInput struct {
+	_ struct{}
+
+	// Only return occurrences of this maintenance window.
+	MaintenanceID int `url:"maintenanceid,omitempty"`
+
+	// Only return occurrences that end after this time (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// Only return occurrences that start before this time (UNIX timestamp).
+	To int `url:"to,omitempty"`
+}
+
+// GetOccurrenceListOutput contains the output for the GetOccurrenceList
+// function.
+type GetOccurrenceListOutput struct {
+	_ struct{}
+
+	// The list of matched occurrences.
+	Occurrences []OccurrenceEntry
+}
+
+// GetOccurrenceList gets a list of maintenance occurrences based on a
+// specific set of filters.
+func (c *Occurrence) GetOccurrenceList(in GetOccurrenceListInput) (out GetOccurrenceListOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/maintenance.occurrences", &in, &out)
+	return
+}
+
+// GetDetailedOccurrenceInput contains the input to send to the
+// GetDetailedOccurrence function.
+type GetDetailedOccurrenceInput struct {
+	_ struct{}
+
+	// The ID of the occurrence to get details for.
+	OccurrenceID int
+}
+
+// GetDetailedOccurrenceOutput contains the output for the
+// GetDetailedOccurrence function.
+type GetDetailedOccurrenceOutput struct {
+	_ struct{}
+
+	// The occurrence.
+	Occurrence OccurrenceEntry
+}
+
+// GetDetailedOccurrence gets detailed information about a single maintenance
+// occurrence.
+func (c *Occurrence) GetDetailedOccurrence(in GetDetailedOccurrenceInput) (out GetDetailedOccurrenceOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/maintenance.occurrences/%d", in.OccurrenceID), nil, &out)
+	return
+}
+
+// ModifyOccurrenceInput contains the input for the ModifyOccurrence function.
+type ModifyOccurrenceInput struct {
+	_ struct{}
+
+	// The ID of the occurrence to modify.
+	OccurrenceID int `url:"-"`
+
+	// The new start time of the occurrence (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// The new end time of the occurrence (UNIX timestamp).
+	To int `url:"to,omitempty"`
+}
+
+// ModifyOccurrenceOutput contains the output for the ModifyOccurrence
+// function.
+type ModifyOccurrenceOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyOccurrence modifies a single maintenance occurrence, without
+// affecting the rest of the maintenance window's schedule.
+func (c *Occurrence) ModifyOccurrence(in ModifyOccurrenceInput) (out ModifyOccurrenceOutput, err error) {
+	err = c.SendRequest("PUT", fmt.Sprintf("/api/2.0/maintenance.occurrences/%d", in.OccurrenceID), &in, &out)
+	return
+}
+
+// DeleteOccurrenceInput contains the input for the DeleteOccurrence function.
+type DeleteOccurrenceInput struct {
+	_ struct{}
+
+	// The ID of the occurrence that you want to delete.
+	OccurrenceID int
+}
+
+// DeleteOccurrenceOutput contains the output for the DeleteOccurrence
+// function.
+type DeleteOccurrenceOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteOccurrence deletes a single maintenance occurrence, without
+// affecting the rest of the maintenance window's schedule.
+//
+// Note that only future occurrences can be deleted.
+func (c *Occurrence) DeleteOccurrence(in DeleteOccurrenceInput) (out DeleteOccurrenceOutput, err error) {
+	err = c.SendRequest("DELETE", fmt.Sprintf("/api/2.0/maintenance.occurrences/%d", in.OccurrenceID), nil, &out)
+	return
+}
+
+// DeleteOccurrencesInput contains the input for the DeleteOccurrences
+// function.
+type DeleteOccurrencesInput struct {
+	_ struct{}
+
+	// The IDs of the occurrences that you want to delete.
+	OccurrenceIDs []int `url:"occurrenceids,comma"`
+}
+
+// DeleteOccurrencesOutput contains the output for the DeleteOccurrences
+// function.
+type DeleteOccurrencesOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteOccurrences deletes several maintenance occurrences in a single
+// request.
+func (c *Occurrence) DeleteOccurrences(in DeleteOccurrencesInput) (out DeleteOccurrencesOutput, err error) {
+	err = c.SendRequest("DELETE", "/api/2.0/maintenance.occurrences", &in, &out)
+	return
+}
@@ -0,0 +1,497 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package occurrences
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getOccurrenceListInputData() GetOccurrenceListInput {
+	return GetOccurrenceListInput{
+		MaintenanceID: 15,
+		From:          1496136600,
+		To:            1497346200,
+	}
+}
+
+const getOccurrenceListInputText = "from=1496136600&maintenanceid=15&to=1497346200"
+
+func getOccurrenceListOutputData() GetOccurrenceListOutput {
+	return GetOccurrenceListOutput{
+		Occurrences: []OccurrenceEntry{
+			OccurrenceEntry{
+				ID:            51,
+				MaintenanceID: 15,
+				From:          1496136600,
+				To:            1496138400,
+			},
+			OccurrenceEntry{
+				ID:            52,
+				MaintenanceID: 15,
+				From:          1496741400,
+				To:            1496743200,
+			},
+		},
+	}
+}
+
+const getOccurrenceListOutputText = `
+{
+	"occurrences": [{
+		"id": 51,
+		"maintenanceid": 15,
+		"from": 1496136600,
+		"to": 1496138400
+	}, {
+		"id": 52,
+		"maintenanceid": 15,
+		"from": 1496741400,
+		"to": 1496743200
+	}]
+}
+`
+
+func httpGetOccurrenceListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getOccurrenceListOutputText, http.StatusOK)
+	})
+}
+
+func getDetailedOccurrenceInputData() GetDetailedOccurrenceInput {
+	return GetDetailedOccurrenceInput{
+		OccurrenceID: 51,
+	}
+}
+
+func getDetailedOccurrenceOutputData() GetDetailedOccurrenceOutput {
+	return GetDetailedOccurrenceOutput{
+		Occurrence: OccurrenceEntry{
+			ID:            51,
+			MaintenanceID: 15,
+			From:          1496136600,
+			To:            1496138400,
+		},
+	}
+}
+
+const getDetailedOccurrenceOutputText = `
+{
+	"occurrence": {
+		"id": 51,
+		"maintenanceid": 15,
+		"from": 1496136600,
+		"to": 1496138400
+	}
+}
+`
+
+func httpGetDetailedOccurrenceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getDetailedOccurrenceOutputText, http.StatusOK)
+	})
+}
+
+func modifyOccurrenceInputData() ModifyOccurrenceInput {
+	return ModifyOccurrenceInput{
+		OccurrenceID: 51,
+		From:         1496137500,
+		To:           1496139300,
+	}
+}
+
+const modifyOccurrenceInputText = "from=1496137500&to=1496139300"
+
+func modifyOccurrenceOutputData() ModifyOccurrenceOutput {
+	return ModifyOccurrenceOutput{
+		Message: "Occurrence successfully modified!",
+	}
+}
+
+const modifyOccurrenceOutputText = `
+{
+	"message": "Occurrence successfully modified!"
+}
+`
+
+func httpModifyOccurrenceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyOccurrenceOutputText, http.StatusOK)
+	})
+}
+
+func deleteOccurrenceInputData() DeleteOccurrenceInput {
+	return DeleteOccurrenceInput{
+		OccurrenceID: 51,
+	}
+}
+
+func deleteOccurrenceOutputData() DeleteOccurrenceOutput {
+	return DeleteOccurrenceOutput{
+		Message: "Occurrence successfully deleted!",
+	}
+}
+
+const deleteOccurrenceOutputText = `
+{
+	"message": "Occurrence successfully deleted!"
+}
+`
+
+func httpDeleteOccurrenceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteOccurrenceOutputText, http.StatusOK)
+	})
+}
+
+func deleteOccurrencesInputData() DeleteOccurrencesInput {
+	return DeleteOccurrencesInput{
+		OccurrenceIDs: []int{51, 52},
+	}
+}
+
+const deleteOccurrencesInputText = "occurrenceids=51%2C52"
+
+func deleteOccurrencesOutputData() DeleteOccurrencesOutput {
+	return DeleteOccurrencesOutput{
+		Message: "2 occurrences successfully deleted.",
+	}
+}
+
+const deleteOccurrencesOutputText = `
+{
+	"message": "2 occurrences successfully deleted."
+}
+`
+
+func httpDeleteOccurrencesTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteOccurrencesOutputText, http.StatusOK)
+	})
+}
+
+func TestOccurrenceNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestOccurrenceNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetOccurrenceListQueryText(t *testing.T) {
+	in := getOccurrenceListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getOccurrenceListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetOccurrenceList(t *testing.T) {
+	ts := httpGetOccurrenceListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getOccurrenceListInputData()
+	out, err := c.GetOccurrenceList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getOccurrenceListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetOccurrenceListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getOccurrenceListInputData()
+	_, err := c.GetOccurrenceList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetDetailedOccurrence(t *testing.T) {
+	ts := httpGetDetailedOccurrenceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedOccurrenceInputData()
+	out, err := c.GetDetailedOccurrence(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getDetailedOccurrenceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetDetailedOccurrenceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedOccurrenceInputData()
+	_, err := c.GetDetailedOccurrence(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyOccurrenceQueryText(t *testing.T) {
+	in := modifyOccurrenceInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyOccurrenceInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyOccurrence(t *testing.T) {
+	ts := httpModifyOccurrenceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyOccurrenceInputData()
+	out, err := c.ModifyOccurrence(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyOccurrenceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyOccurrenceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyOccurrenceInputData()
+	_, err := c.ModifyOccurrence(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteOccurrence(t *testing.T) {
+	ts := httpDeleteOccurrenceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteOccurrenceInputData()
+	out, err := c.DeleteOccurrence(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteOccurrenceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteOccurrenceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteOccurrenceInputData()
+	_, err := c.DeleteOccurrence(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteOccurrencesQueryText(t *testing.T) {
+	in := deleteOccurrencesInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := deleteOccurrencesInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestDeleteOccurrences(t *testing.T) {
+	ts := httpDeleteOccurrencesTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteOccurrencesInputData()
+	out, err := c.DeleteOccurrences(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteOccurrencesOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteOccurrencesError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteOccurrencesInputData()
+	_, err := c.DeleteOccurrences(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
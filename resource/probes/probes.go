@@ -0,0 +1,102 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package probes contains the methods necessary for retrieving the probe
+// servers that Pingdom runs tests from.
+package probes
+
+import (
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Probe is the base client for probe-related methods.
+type Probe struct {
+	client.Client
+}
+
+// New returns a new instance of the Probe API.
+func New(configs ...pingdom.Config) *Probe {
+	c := &Probe{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// ProbeListEntry holds a single probe from GetProbeListOutput.
+type ProbeListEntry struct {
+	_ struct{}
+
+	// The probe identifier.
+	ID int
+
+	// The country the probe is located in.
+	Country string
+
+	// The city the probe is located in.
+	City string
+
+	// The probe name.
+	Name string
+
+	// true if the probe is active.
+	Active bool
+
+	// The probe DNS name.
+	Hostname string
+
+	// The probe IPv4 address.
+	IP string
+
+	// The probe IPv6 address.
+	IPv6 string
+
+	// The country ISO code of the probe location.
+	CountryISO string
+
+	// The region the probe is located in, such as NA, EU, APAC, or LATAM.
+	Region string
+}
+
+// GetProbeListInput contains the input to send to the GetProbeList function.
+type GetProbeListInput struct {
+	_ struct{}
+
+	// Limits the number of returned probes to the specified quantity.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset for the probe listing. Requires Limit.
+	Offset int `url:"offset,omitempty"`
+
+	// Only return active probes.
+	OnlyActive bool `url:"onlyactive,omitempty"`
+
+	// Include probes that have been removed from service.
+	IncludeDeleted bool `url:"includedeleted,omitempty"`
+}
+
+// GetProbeListOutput contains the output for the GetProbeList function.
+type GetProbeListOutput struct {
+	_ struct{}
+
+	// The list of matched probes.
+	Probes []ProbeListEntry
+}
+
+// GetProbeList gets a list of Pingdom probe servers based on a specific set
+// of filters.
+func (c *Probe) GetProbeList(in GetProbeListInput) (out GetProbeListOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/probes", &in, &out)
+	return
+}
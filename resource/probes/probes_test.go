@@ -0,0 +1,242 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/integration"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getProbeListInputData() GetProbeListInput {
+	return GetProbeListInput{
+		Limit:      2,
+		OnlyActive: true,
+	}
+}
+
+const getProbeListInputText = "limit=2&onlyactive=true"
+
+func getProbeListOutputData() GetProbeListOutput {
+	return GetProbeListOutput{
+		Probes: []ProbeListEntry{
+			ProbeListEntry{
+				ID:         1,
+				Country:    "United Kingdom",
+				City:       "Manchester",
+				Name:       "Manchester, UK",
+				Active:     true,
+				Hostname:   "s413.pingdom.com",
+				IP:         "46.251.28.5",
+				IPv6:       "2a00:a480:1:1::1",
+				CountryISO: "GB",
+				Region:     "EU",
+			},
+			ProbeListEntry{
+				ID:         2,
+				Country:    "United States",
+				City:       "New York",
+				Name:       "New York, NY",
+				Active:     true,
+				Hostname:   "s414.pingdom.com",
+				IP:         "69.59.28.19",
+				IPv6:       "",
+				CountryISO: "US",
+				Region:     "NA",
+			},
+		},
+	}
+}
+
+const getProbeListOutputText = `
+{
+	"probes": [{
+		"id": 1,
+		"country": "United Kingdom",
+		"city": "Manchester",
+		"name": "Manchester, UK",
+		"active": true,
+		"hostname": "s413.pingdom.com",
+		"ip": "46.251.28.5",
+		"ipv6": "2a00:a480:1:1::1",
+		"countryiso": "GB",
+		"region": "EU"
+	}, {
+		"id": 2,
+		"country": "United States",
+		"city": "New York",
+		"name": "New York, NY",
+		"active": true,
+		"hostname": "s414.pingdom.com",
+		"ip": "69.59.28.19",
+		"ipv6": "",
+		"countryiso": "US",
+		"region": "NA"
+	}]
+}
+`
+
+func httpGetProbeListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getProbeListOutputText, http.StatusOK)
+	})
+}
+
+func TestProbeNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestProbeNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetProbeListQueryText(t *testing.T) {
+	in := getProbeListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getProbeListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetProbeList(t *testing.T) {
+	ts := httpGetProbeListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getProbeListInputData()
+	out, err := c.GetProbeList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getProbeListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetProbeListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getProbeListInputData()
+	_, err := c.GetProbeList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+// TestAccProbesList runs a basic list test against the Pingdom probe list.
+func TestAccProbesList(t *testing.T) {
+	testacc.VetAccConditions(t)
+
+	c := New()
+	out, err := c.GetProbeList(GetProbeListInput{OnlyActive: true})
+	if err != nil {
+		t.Fatalf("Error listing probes: %v", err)
+	}
+	if len(out.Probes) == 0 {
+		t.Fatalf("Expected at least one active probe, got none")
+	}
+	for _, v := range out.Probes {
+		if v.Active == false {
+			t.Fatalf("Expected only active probes, got inactive probe %d", v.ID)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publicreports contains the methods necessary for managing which
+// checks are published on the Pingdom public status page.
+//
+// Public reports can only be managed with an email address, password, and
+// application key. All functions return an error when an API token is
+// configured.
+package publicreports
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// PublicReport is the base client for public report-related methods.
+type PublicReport struct {
+	client.Client
+}
+
+// New returns a new instance of the PublicReport API.
+func New(configs ...pingdom.Config) *PublicReport {
+	c := &PublicReport{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all public report functions when using
+// an API token, as version 3.1 of the API has no public reports endpoint.
+var errTokenNotSupported = errors.New("Public reports cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *PublicReport) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// PublicReportListEntry holds a single published check from
+// GetPublicReportListOutput.
+type PublicReportListEntry struct {
+	_ struct{}
+
+	// The check identifier.
+	CheckID int
+
+	// The check name.
+	CheckName string
+
+	// The URL of the public report for the check.
+	ReportURL string
+}
+
+// GetPublicReportListInput contains the input to send to the
+// GetPublicReportList function. The public report list endpoint takes no
+// parameters.
+type GetPublicReportListInput struct {
+	_ struct{}
+}
+
+// GetPublicReportListOutput contains the output for the GetPublicReportList
+// function.
+type GetPublicReportListOutput struct {
+	_ struct{}
+
+	// The list of published checks.
+	Public []PublicReportListEntry
+}
+
+// GetPublicReportList gets a list of the checks that are published on the
+// public status page.
+func (c *PublicReport) GetPublicReportList(in GetPublicReportListInput) (out GetPublicReportListOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.0/reports.public", &in, &out)
+	return
+}
+
+// PublishPublicReportInput contains the input for the PublishPublicReport
+// function.
+type PublishPublicReportInput struct {
+	_ struct{}
+
+	// The ID of the check to publish.
+	CheckID int
+}
+
+// PublishPublicReportOutput contains the output for the PublishPublicReport
+// function.
+type PublishPublicReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// PublishPublicReport publishes a check on the public status page.
+func (c *PublicReport) PublishPublicReport(in PublishPublicReportInput) (out PublishPublicReportOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.0/reports.public/%d", in.CheckID), nil, &out)
+	return
+}
+
+// WithdrawPublicReportInput contains the input for the WithdrawPublicReport
+// function.
+type WithdrawPublicReportInput struct {
+	_ struct{}
+
+	// The ID of the check to withdraw.
+	CheckID int
+}
+
+// WithdrawPublicReportOutput contains the output for the
+// WithdrawPublicReport function.
+type WithdrawPublicReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// WithdrawPublicReport removes a check from the public status page. The
+// check itself is not affected.
+func (c *PublicReport) WithdrawPublicReport(in WithdrawPublicReportInput) (out WithdrawPublicReportOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.0/reports.public/%d", in.CheckID), nil, &out)
+	return
+}
@@ -0,0 +1,324 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publicreports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getPublicReportListInputData() GetPublicReportListInput {
+	return GetPublicReportListInput{}
+}
+
+func getPublicReportListOutputData() GetPublicReportListOutput {
+	return GetPublicReportListOutput{
+		Public: []PublicReportListEntry{
+			PublicReportListEntry{
+				CheckID:   85975,
+				CheckName: "My check 1",
+				ReportURL: "http://stats.pingdom.com/s3iwbdjd4w8x/85975",
+			},
+			PublicReportListEntry{
+				CheckID:   161748,
+				CheckName: "My check 2",
+				ReportURL: "http://stats.pingdom.com/s3iwbdjd4w8x/161748",
+			},
+		},
+	}
+}
+
+const getPublicReportListOutputText = `
+{
+	"public": [{
+		"checkid": 85975,
+		"checkname": "My check 1",
+		"reporturl": "http://stats.pingdom.com/s3iwbdjd4w8x/85975"
+	}, {
+		"checkid": 161748,
+		"checkname": "My check 2",
+		"reporturl": "http://stats.pingdom.com/s3iwbdjd4w8x/161748"
+	}]
+}
+`
+
+func httpGetPublicReportListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getPublicReportListOutputText, http.StatusOK)
+	})
+}
+
+func publishPublicReportInputData() PublishPublicReportInput {
+	return PublishPublicReportInput{
+		CheckID: 85975,
+	}
+}
+
+func publishPublicReportOutputData() PublishPublicReportOutput {
+	return PublishPublicReportOutput{
+		Message: "Check 85975 published",
+	}
+}
+
+const publishPublicReportOutputText = `
+{
+	"message": "Check 85975 published"
+}
+`
+
+func httpPublishPublicReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, publishPublicReportOutputText, http.StatusOK)
+	})
+}
+
+func withdrawPublicReportInputData() WithdrawPublicReportInput {
+	return WithdrawPublicReportInput{
+		CheckID: 85975,
+	}
+}
+
+func withdrawPublicReportOutputData() WithdrawPublicReportOutput {
+	return WithdrawPublicReportOutput{
+		Message: "Check 85975 withdrawn",
+	}
+}
+
+const withdrawPublicReportOutputText = `
+{
+	"message": "Check 85975 withdrawn"
+}
+`
+
+func httpWithdrawPublicReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, withdrawPublicReportOutputText, http.StatusOK)
+	})
+}
+
+func TestPublicReportNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestPublicReportNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetPublicReportList(t *testing.T) {
+	ts := httpGetPublicReportListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getPublicReportListInputData()
+	out, err := c.GetPublicReportList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getPublicReportListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetPublicReportListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getPublicReportListInputData()
+	_, err := c.GetPublicReportList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestPublishPublicReport(t *testing.T) {
+	ts := httpPublishPublicReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := publishPublicReportInputData()
+	out, err := c.PublishPublicReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := publishPublicReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestPublishPublicReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := publishPublicReportInputData()
+	_, err := c.PublishPublicReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestWithdrawPublicReport(t *testing.T) {
+	ts := httpWithdrawPublicReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := withdrawPublicReportInputData()
+	out, err := c.WithdrawPublicReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := withdrawPublicReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestWithdrawPublicReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := withdrawPublicReportInputData()
+	_, err := c.WithdrawPublicReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestPublicReportsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Public reports cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetPublicReportList(getPublicReportListInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetPublicReportList to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.PublishPublicReport(publishPublicReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected PublishPublicReport to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.WithdrawPublicReport(withdrawPublicReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected WithdrawPublicReport to fail with %s, got %v", expected, err)
+	}
+}
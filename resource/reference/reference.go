@@ -0,0 +1,246 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reference contains the methods necessary for retrieving the
+// reference data (regions, time zones, countries, etc) that Pingdom accepts
+// in other parts of the API.
+package reference
+
+import (
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Reference is the base client for reference-related methods.
+type Reference struct {
+	client.Client
+}
+
+// New returns a new instance of the Reference API.
+func New(configs ...pingdom.Config) *Reference {
+	c := &Reference{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// RegionEntry holds a single region from GetReferenceOutput. A region
+// bundles the default country, time zone, and formats for an account.
+type RegionEntry struct {
+	_ struct{}
+
+	// The region identifier.
+	ID int
+
+	// The region description.
+	Description string
+
+	// The ID of the default country for the region.
+	CountryID int
+
+	// The ID of the default date/time format for the region.
+	DatetimeFormatID int
+
+	// The ID of the default number format for the region.
+	NumberFormatID int
+
+	// The ID of the default time zone for the region.
+	TimeZoneID int
+}
+
+// TimeZoneEntry holds a single time zone from GetReferenceOutput.
+type TimeZoneEntry struct {
+	_ struct{}
+
+	// The time zone identifier.
+	ID int
+
+	// The time zone description.
+	Description string
+}
+
+// DatetimeFormatEntry holds a single date/time format from
+// GetReferenceOutput.
+type DatetimeFormatEntry struct {
+	_ struct{}
+
+	// The date/time format identifier.
+	ID int
+
+	// The date/time format description.
+	Description string
+}
+
+// NumberFormatEntry holds a single number format from GetReferenceOutput.
+type NumberFormatEntry struct {
+	_ struct{}
+
+	// The number format identifier.
+	ID int
+
+	// The number format description.
+	Description string
+}
+
+// CountryEntry holds a single country from GetReferenceOutput.
+type CountryEntry struct {
+	_ struct{}
+
+	// The country identifier.
+	ID int
+
+	// The country ISO code.
+	ISO string
+}
+
+// PhoneCodeEntry holds a single phone code from GetReferenceOutput.
+type PhoneCodeEntry struct {
+	_ struct{}
+
+	// The ID of the country the phone code belongs to.
+	CountryID int
+
+	// The country name.
+	Name string
+
+	// The country calling code, without a leading plus sign.
+	PhoneCode string
+}
+
+// GetReferenceInput contains the input to send to the GetReference function.
+// The reference data endpoint takes no parameters.
+type GetReferenceInput struct {
+	_ struct{}
+}
+
+// GetReferenceOutput contains the output for the GetReference function.
+type GetReferenceOutput struct {
+	_ struct{}
+
+	// The available regions.
+	Regions []RegionEntry
+
+	// The available time zones.
+	TimeZones []TimeZoneEntry
+
+	// The available date/time formats.
+	DatetimeFormats []DatetimeFormatEntry
+
+	// The available number formats.
+	NumberFormats []NumberFormatEntry
+
+	// The available countries.
+	Countries []CountryEntry
+
+	// The available phone codes.
+	PhoneCodes []PhoneCodeEntry
+}
+
+// GetReference gets the reference data for regions, time zones, date/time
+// formats, number formats, countries, and phone codes.
+func (c *Reference) GetReference(in GetReferenceInput) (out GetReferenceOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/reference", &in, &out)
+	return
+}
+
+// ValidRegionID returns true if id is a known region ID.
+func (r GetReferenceOutput) ValidRegionID(id int) bool {
+	for _, v := range r.Regions {
+		if v.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidTimeZoneID returns true if id is a known time zone ID.
+func (r GetReferenceOutput) ValidTimeZoneID(id int) bool {
+	for _, v := range r.TimeZones {
+		if v.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidDatetimeFormatID returns true if id is a known date/time format ID.
+func (r GetReferenceOutput) ValidDatetimeFormatID(id int) bool {
+	for _, v := range r.DatetimeFormats {
+		if v.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidNumberFormatID returns true if id is a known number format ID.
+func (r GetReferenceOutput) ValidNumberFormatID(id int) bool {
+	for _, v := range r.NumberFormats {
+		if v.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidCountryISO returns true if iso is a known country ISO code.
+func (r GetReferenceOutput) ValidCountryISO(iso string) bool {
+	for _, v := range r.Countries {
+		if v.ISO == iso {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePhone checks a country calling code and country ISO code pair,
+// such as the CountryCode and CountryISO fields of a contact, against the
+// reference data. An error is returned if either value is missing or unknown,
+// or if the calling code does not belong to the country.
+func (r GetReferenceOutput) ValidatePhone(countryCode, countryISO string) error {
+	if countryCode == "" {
+		return fmt.Errorf("Phone code is required with country ISO code %s", countryISO)
+	}
+	if countryISO == "" {
+		return fmt.Errorf("Country ISO code is required with phone code %s", countryCode)
+	}
+
+	var countryID int
+	for _, v := range r.Countries {
+		if v.ISO == countryISO {
+			countryID = v.ID
+			break
+		}
+	}
+	if countryID == 0 {
+		return fmt.Errorf("Country ISO code %s is not known to Pingdom", countryISO)
+	}
+
+	var found bool
+	for _, v := range r.PhoneCodes {
+		if v.PhoneCode != countryCode {
+			continue
+		}
+		found = true
+		if v.CountryID == countryID {
+			return nil
+		}
+	}
+	if found == false {
+		return fmt.Errorf("Phone code %s is not known to Pingdom", countryCode)
+	}
+	return fmt.Errorf("Phone code %s does not belong to country %s", countryCode, countryISO)
+}
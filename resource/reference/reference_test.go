@@ -0,0 +1,323 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reference
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getReferenceInputData() GetReferenceInput {
+	return GetReferenceInput{}
+}
+
+func getReferenceOutputData() GetReferenceOutput {
+	return GetReferenceOutput{
+		Regions: []RegionEntry{
+			RegionEntry{
+				ID:               1,
+				Description:      "Sweden (Stockholm)",
+				CountryID:        205,
+				DatetimeFormatID: 2,
+				NumberFormatID:   3,
+				TimeZoneID:       44,
+			},
+		},
+		TimeZones: []TimeZoneEntry{
+			TimeZoneEntry{
+				ID:          44,
+				Description: "(GMT +01:00) Stockholm",
+			},
+		},
+		DatetimeFormats: []DatetimeFormatEntry{
+			DatetimeFormatEntry{
+				ID:          2,
+				Description: "yyyy-mm-dd hh:mm:ss",
+			},
+		},
+		NumberFormats: []NumberFormatEntry{
+			NumberFormatEntry{
+				ID:          3,
+				Description: "123 456,00",
+			},
+		},
+		Countries: []CountryEntry{
+			CountryEntry{
+				ID:  205,
+				ISO: "SE",
+			},
+			CountryEntry{
+				ID:  39,
+				ISO: "CA",
+			},
+			CountryEntry{
+				ID:  226,
+				ISO: "US",
+			},
+		},
+		PhoneCodes: []PhoneCodeEntry{
+			PhoneCodeEntry{
+				CountryID: 205,
+				Name:      "Sweden",
+				PhoneCode: "46",
+			},
+			PhoneCodeEntry{
+				CountryID: 39,
+				Name:      "Canada",
+				PhoneCode: "1",
+			},
+			PhoneCodeEntry{
+				CountryID: 226,
+				Name:      "United States",
+				PhoneCode: "1",
+			},
+		},
+	}
+}
+
+const getReferenceOutputText = `
+{
+	"regions": [{
+		"id": 1,
+		"description": "Sweden (Stockholm)",
+		"countryid": 205,
+		"datetimeformatid": 2,
+		"numberformatid": 3,
+		"timezoneid": 44
+	}],
+	"timezones": [{
+		"id": 44,
+		"description": "(GMT +01:00) Stockholm"
+	}],
+	"datetimeformats": [{
+		"id": 2,
+		"description": "yyyy-mm-dd hh:mm:ss"
+	}],
+	"numberformats": [{
+		"id": 3,
+		"description": "123 456,00"
+	}],
+	"countries": [{
+		"id": 205,
+		"iso": "SE"
+	}, {
+		"id": 39,
+		"iso": "CA"
+	}, {
+		"id": 226,
+		"iso": "US"
+	}],
+	"phonecodes": [{
+		"countryid": 205,
+		"name": "Sweden",
+		"phonecode": "46"
+	}, {
+		"countryid": 39,
+		"name": "Canada",
+		"phonecode": "1"
+	}, {
+		"countryid": 226,
+		"name": "United States",
+		"phonecode": "1"
+	}]
+}
+`
+
+func httpGetReferenceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getReferenceOutputText, http.StatusOK)
+	})
+}
+
+func TestReferenceNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestReferenceNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetReference(t *testing.T) {
+	ts := httpGetReferenceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getReferenceInputData()
+	out, err := c.GetReference(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getReferenceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetReferenceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getReferenceInputData()
+	_, err := c.GetReference(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetReferenceOutputValidIDs(t *testing.T) {
+	r := getReferenceOutputData()
+
+	if r.ValidRegionID(1) == false {
+		t.Fatalf("Expected region ID 1 to be valid")
+	}
+	if r.ValidRegionID(2) == true {
+		t.Fatalf("Expected region ID 2 to be invalid")
+	}
+	if r.ValidTimeZoneID(44) == false {
+		t.Fatalf("Expected time zone ID 44 to be valid")
+	}
+	if r.ValidTimeZoneID(45) == true {
+		t.Fatalf("Expected time zone ID 45 to be invalid")
+	}
+	if r.ValidDatetimeFormatID(2) == false {
+		t.Fatalf("Expected date/time format ID 2 to be valid")
+	}
+	if r.ValidDatetimeFormatID(3) == true {
+		t.Fatalf("Expected date/time format ID 3 to be invalid")
+	}
+	if r.ValidNumberFormatID(3) == false {
+		t.Fatalf("Expected number format ID 3 to be valid")
+	}
+	if r.ValidNumberFormatID(4) == true {
+		t.Fatalf("Expected number format ID 4 to be invalid")
+	}
+	if r.ValidCountryISO("SE") == false {
+		t.Fatalf("Expected country ISO SE to be valid")
+	}
+	if r.ValidCountryISO("XX") == true {
+		t.Fatalf("Expected country ISO XX to be invalid")
+	}
+}
+
+func TestGetReferenceOutputValidatePhone(t *testing.T) {
+	r := getReferenceOutputData()
+	cases := []struct {
+		CountryCode string
+		CountryISO  string
+		Expected    string
+	}{
+		{CountryCode: "46", CountryISO: "SE", Expected: ""},
+		{CountryCode: "1", CountryISO: "CA", Expected: ""},
+		{CountryCode: "1", CountryISO: "US", Expected: ""},
+		{CountryCode: "46", CountryISO: "XX", Expected: "Country ISO code XX is not known to Pingdom"},
+		{CountryCode: "999", CountryISO: "SE", Expected: "Phone code 999 is not known to Pingdom"},
+		{CountryCode: "1", CountryISO: "SE", Expected: "Phone code 1 does not belong to country SE"},
+		{CountryCode: "", CountryISO: "SE", Expected: "Phone code is required with country ISO code SE"},
+		{CountryCode: "46", CountryISO: "", Expected: "Country ISO code is required with phone code 46"},
+	}
+
+	for _, tc := range cases {
+		err := r.ValidatePhone(tc.CountryCode, tc.CountryISO)
+		switch {
+		case tc.Expected == "" && err != nil:
+			t.Fatalf("Unexpected error for %s/%s: %s", tc.CountryCode, tc.CountryISO, err)
+		case tc.Expected != "" && err == nil:
+			t.Fatalf("Expected error for %s/%s, none found", tc.CountryCode, tc.CountryISO)
+		case tc.Expected != "" && err.Error() != tc.Expected:
+			t.Fatalf("expected %s, got %s", tc.Expected, err)
+		}
+	}
+}
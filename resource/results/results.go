@@ -0,0 +1,117 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package results contains the methods necessary for retrieving raw check
+// results from Pingdom.
+package results
+
+import (
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Result is the base client for result-related methods.
+type Result struct {
+	client.Client
+}
+
+// New returns a new instance of the Result API.
+func New(configs ...pingdom.Config) *Result {
+	c := &Result{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// RawCheckResultEntry holds a single result from GetRawCheckResultsOutput.
+type RawCheckResultEntry struct {
+	_ struct{}
+
+	// The ID of the probe that ran the test.
+	ProbeID int
+
+	// The time of the test (UNIX timestamp).
+	Time int
+
+	// The result status. One of up, down, unconfirmed_down, or unknown.
+	Status string
+
+	// The response time (in milliseconds) of the test.
+	ResponseTime int
+
+	// A short status description.
+	StatusDesc string
+
+	// A long status description.
+	StatusDescLong string
+
+	// The ID of the root cause analysis for this result, if any. Only
+	// returned when IncludeAnalysis is set.
+	AnalysisID int
+}
+
+// GetRawCheckResultsInput contains the input to send to the
+// GetRawCheckResults function.
+type GetRawCheckResultsInput struct {
+	_ struct{}
+
+	// The ID of the check to get results for.
+	CheckID int `url:"-"`
+
+	// The start of the period to get results for (UNIX timestamp). Defaults
+	// to one day prior to To.
+	From int `url:"from,omitempty"`
+
+	// The end of the period to get results for (UNIX timestamp). Defaults to
+	// the current time.
+	To int `url:"to,omitempty"`
+
+	// Filter results to a list of probe IDs.
+	Probes []int `url:"probes,comma,omitempty"`
+
+	// Filter results to a list of statuses. Each entry can be one of up,
+	// down, unconfirmed, or unknown.
+	Status []string `url:"status,comma,omitempty"`
+
+	// Limits the number of returned results to the specified quantity.
+	// Max value is 1000.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset for the result listing. Max value is 43200.
+	Offset int `url:"offset,omitempty"`
+
+	// Include root cause analysis IDs for each result.
+	IncludeAnalysis bool `url:"includeanalysis,omitempty"`
+}
+
+// GetRawCheckResultsOutput contains the output for the GetRawCheckResults
+// function.
+type GetRawCheckResultsOutput struct {
+	_ struct{}
+
+	// The list of matched results.
+	Results []RawCheckResultEntry
+
+	// The IDs of the probes that were active during the requested period.
+	ActiveProbes []int
+}
+
+// GetRawCheckResults gets the raw test results for a specific check, based on
+// a specific set of filters.
+func (c *Result) GetRawCheckResults(in GetRawCheckResultsInput) (out GetRawCheckResultsOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/results/%d", in.CheckID), &in, &out)
+	return
+}
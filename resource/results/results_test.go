@@ -0,0 +1,216 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getRawCheckResultsInputData() GetRawCheckResultsInput {
+	return GetRawCheckResultsInput{
+		CheckID:         85975,
+		From:            1294235000,
+		To:              1294236000,
+		Probes:          []int{33, 34},
+		Status:          []string{"up", "down"},
+		Limit:           2,
+		Offset:          0,
+		IncludeAnalysis: true,
+	}
+}
+
+const getRawCheckResultsInputText = "from=1294235000&includeanalysis=true&limit=2&probes=33%2C34&status=up%2Cdown&to=1294236000"
+
+func getRawCheckResultsOutputData() GetRawCheckResultsOutput {
+	return GetRawCheckResultsOutput{
+		Results: []RawCheckResultEntry{
+			RawCheckResultEntry{
+				ProbeID:        33,
+				Time:           1294235764,
+				Status:         "up",
+				ResponseTime:   91,
+				StatusDesc:     "OK",
+				StatusDescLong: "OK",
+			},
+			RawCheckResultEntry{
+				ProbeID:        34,
+				Time:           1294235703,
+				Status:         "down",
+				ResponseTime:   0,
+				StatusDesc:     "Timeout",
+				StatusDescLong: "Timeout (> 30000 ms)",
+				AnalysisID:     7831,
+			},
+		},
+		ActiveProbes: []int{33, 34},
+	}
+}
+
+const getRawCheckResultsOutputText = `
+{
+	"results": [{
+		"probeid": 33,
+		"time": 1294235764,
+		"status": "up",
+		"responsetime": 91,
+		"statusdesc": "OK",
+		"statusdesclong": "OK"
+	}, {
+		"probeid": 34,
+		"time": 1294235703,
+		"status": "down",
+		"responsetime": 0,
+		"statusdesc": "Timeout",
+		"statusdesclong": "Timeout (> 30000 ms)",
+		"analysisid": 7831
+	}],
+	"activeprobes": [33, 34]
+}
+`
+
+func httpGetRawCheckResultsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getRawCheckResultsOutputText, http.StatusOK)
+	})
+}
+
+func TestResultNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestResultNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetRawCheckResultsQueryText(t *testing.T) {
+	in := getRawCheckResultsInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getRawCheckResultsInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetRawCheckResults(t *testing.T) {
+	ts := httpGetRawCheckResultsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getRawCheckResultsInputData()
+	out, err := c.GetRawCheckResults(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getRawCheckResultsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetRawCheckResultsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getRawCheckResultsInputData()
+	_, err := c.GetRawCheckResults(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
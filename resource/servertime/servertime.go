@@ -0,0 +1,86 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package servertime contains the methods necessary for retrieving the
+// current Pingdom server time, and measuring local clock skew against it.
+package servertime
+
+import (
+	"time"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// now is the local clock. It is a variable so that tests can replace it.
+var now = time.Now
+
+// ServerTime is the base client for server time-related methods.
+type ServerTime struct {
+	client.Client
+}
+
+// New returns a new instance of the ServerTime API.
+func New(configs ...pingdom.Config) *ServerTime {
+	c := &ServerTime{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// GetServerTimeInput contains the input to send to the GetServerTime
+// function. The server time endpoint takes no parameters.
+type GetServerTimeInput struct {
+	_ struct{}
+}
+
+// GetServerTimeOutput contains the output for the GetServerTime function.
+type GetServerTimeOutput struct {
+	_ struct{}
+
+	// The current server time (UNIX timestamp).
+	ServerTime int
+}
+
+// GetServerTime gets the current time of the Pingdom API server.
+func (c *ServerTime) GetServerTime(in GetServerTimeInput) (out GetServerTimeOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/servertime", &in, &out)
+	return
+}
+
+// ClockSkew measures the offset between the local clock and the Pingdom
+// server clock. A positive value means the local clock is behind the server.
+//
+// The local time is taken as the midpoint of the request, and the server
+// only reports whole seconds, so the result is accurate to about one second
+// plus any asymmetry in network latency.
+func (c *ServerTime) ClockSkew() (time.Duration, error) {
+	before := now()
+	out, err := c.GetServerTime(GetServerTimeInput{})
+	if err != nil {
+		return 0, err
+	}
+	after := now()
+
+	local := before.Add(after.Sub(before) / 2)
+	server := time.Unix(int64(out.ServerTime), 0).Add(500 * time.Millisecond)
+	return server.Sub(local), nil
+}
+
+// Timestamp converts a local time into a UNIX timestamp on the Pingdom
+// server clock, using a skew measured by ClockSkew. The result can be used
+// for the From and To fields of time-range inputs in other services.
+func Timestamp(t time.Time, skew time.Duration) int {
+	return int(t.Add(skew).Unix())
+}
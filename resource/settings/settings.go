@@ -0,0 +1,358 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package settings contains the methods necessary for managing Pingdom
+// account settings.
+//
+// Account settings can only be managed with an email address, password, and
+// application key. All functions return an error when an API token is
+// configured.
+package settings
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+	"github.com/paybyphone/pingdom-go-sdk/resource/reference"
+)
+
+// Settings is the base client for account settings-related methods.
+type Settings struct {
+	client.Client
+}
+
+// New returns a new instance of the Settings API.
+func New(configs ...pingdom.Config) *Settings {
+	c := &Settings{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all account settings functions when
+// using an API token, as version 3.1 of the API has no account settings
+// endpoint.
+var errTokenNotSupported = errors.New("Account settings cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *Settings) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// SettingsCountryEntry contains the account country returned by
+// GetAccountSettings.
+type SettingsCountryEntry struct {
+	_ struct{}
+
+	// The country name.
+	Name string
+
+	// The country ISO code.
+	ISO string
+
+	// The country identifier.
+	CountryID int
+}
+
+// SettingsTimeZoneEntry contains the account time zone returned by
+// GetAccountSettings.
+type SettingsTimeZoneEntry struct {
+	_ struct{}
+
+	// The time zone identifier.
+	ID int
+
+	// The time zone description.
+	Description string
+}
+
+// SettingsPublicReportsEntry contains the public reports settings returned
+// by GetAccountSettings.
+type SettingsPublicReportsEntry struct {
+	_ struct{}
+
+	// Use a custom design for public reports.
+	CustomDesign bool
+
+	// The public reports text color.
+	TextColor string
+
+	// The public reports background color.
+	BackgroundColor string
+
+	// The URL of the logo shown on public reports.
+	LogoURL string
+
+	// The number of months of history shown on public reports.
+	Months string
+
+	// Show the overview page on public reports.
+	ShowOverview bool
+
+	// Public reports are served from a custom domain.
+	CustomDomain bool
+}
+
+// SettingsEntry contains the account settings returned by
+// GetAccountSettings.
+type SettingsEntry struct {
+	_ struct{}
+
+	// The account owner's first name.
+	FirstName string
+
+	// The account owner's last name.
+	LastName string
+
+	// The company name.
+	Company string
+
+	// The account email address.
+	Email string
+
+	// The phone number.
+	Phone string
+
+	// The phone number country ISO code.
+	PhoneCountryISO string
+
+	// The cell phone number.
+	CellPhone string
+
+	// The cell phone number country ISO code.
+	CellPhoneCountryISO string
+
+	// The first line of the address.
+	Address string
+
+	// The second line of the address.
+	Address2 string
+
+	// The zip or postal code.
+	Zip string
+
+	// The city or location.
+	Location string
+
+	// The state, province, or region.
+	State string
+
+	// The account country.
+	Country SettingsCountryEntry
+
+	// The VAT code, if any.
+	VATCode string
+
+	// Log out of the web interface automatically.
+	AutoLogout bool
+
+	// The account region.
+	Region string
+
+	// The account time zone.
+	TimeZone SettingsTimeZoneEntry
+
+	// The date/time format.
+	DatetimeFormat string
+
+	// The number format.
+	NumberFormat string
+
+	// The public reports settings.
+	PublicReports SettingsPublicReportsEntry
+}
+
+// GetAccountSettingsInput contains the input to send to the
+// GetAccountSettings function. The settings endpoint takes no parameters.
+type GetAccountSettingsInput struct {
+	_ struct{}
+}
+
+// GetAccountSettingsOutput contains the output for the GetAccountSettings
+// function.
+type GetAccountSettingsOutput struct {
+	_ struct{}
+
+	// The account settings.
+	Settings SettingsEntry
+}
+
+// GetAccountSettings gets the settings for the Pingdom account.
+func (c *Settings) GetAccountSettings(in GetAccountSettingsInput) (out GetAccountSettingsOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.0/settings", &in, &out)
+	return
+}
+
+// ModifyAccountSettingsInput contains the input for the ModifyAccountSettings
+// function.
+type ModifyAccountSettingsInput struct {
+	_ struct{}
+
+	// The account owner's first name.
+	FirstName string `url:"firstname,omitempty"`
+
+	// The account owner's last name.
+	LastName string `url:"lastname,omitempty"`
+
+	// The company name.
+	Company string `url:"company,omitempty"`
+
+	// The account email address.
+	Email string `url:"email,omitempty"`
+
+	// The cell phone number, without the country code. Requires
+	// CellCountryCode and CellCountryISO.
+	CellPhone string `url:"cellphone,omitempty"`
+
+	// The cell phone country code. Requires CellPhone and CellCountryISO.
+	CellCountryCode string `url:"cellcountrycode,omitempty"`
+
+	// The cell phone country ISO code. Requires CellPhone and
+	// CellCountryCode.
+	CellCountryISO string `url:"cellcountryiso,omitempty"`
+
+	// The phone number, without the country code. Requires
+	// PhoneCountryCode and PhoneCountryISO.
+	Phone string `url:"phone,omitempty"`
+
+	// The phone country code. Requires Phone and PhoneCountryISO.
+	PhoneCountryCode string `url:"phonecountrycode,omitempty"`
+
+	// The phone country ISO code. Requires Phone and PhoneCountryCode.
+	PhoneCountryISO string `url:"phonecountryiso,omitempty"`
+
+	// The first line of the address.
+	Address string `url:"address,omitempty"`
+
+	// The second line of the address.
+	Address2 string `url:"address2,omitempty"`
+
+	// The zip or postal code.
+	Zip string `url:"zip,omitempty"`
+
+	// The city or location.
+	Location string `url:"location,omitempty"`
+
+	// The state, province, or region.
+	State string `url:"state,omitempty"`
+
+	// The account country ISO code.
+	CountryISO string `url:"countryiso,omitempty"`
+
+	// The VAT code.
+	VATCode string `url:"vatcode,omitempty"`
+
+	// Set to true to log out of the web interface automatically, or false to
+	// stay logged in. Left unset, the setting is not changed.
+	AutoLogout *bool `url:"autologout,omitempty"`
+
+	// The region ID. See the reference package for valid values.
+	RegionID int `url:"regionid,omitempty"`
+
+	// The time zone ID. See the reference package for valid values.
+	TimeZoneID int `url:"timezoneid,omitempty"`
+
+	// The date/time format ID. See the reference package for valid values.
+	DatetimeFormatID int `url:"datetimeformatid,omitempty"`
+
+	// The number format ID. See the reference package for valid values.
+	NumberFormatID int `url:"numberformatid,omitempty"`
+
+	// Set to true to use a custom design for public reports, or false to use
+	// the default design. Left unset, the setting is not changed.
+	PubRCustomDesign *bool `url:"pubrcustomdesign,omitempty"`
+
+	// The public reports text color, in hex format (ie: 000000).
+	PubRTextColor string `url:"pubrtextcolor,omitempty"`
+
+	// The public reports background color, in hex format (ie: FFFFFF).
+	PubRBackgroundColor string `url:"pubrbackgroundcolor,omitempty"`
+
+	// The URL of the logo shown on public reports.
+	PubRLogoURL string `url:"pubrlogourl,omitempty"`
+
+	// The number of months of history shown on public reports. One of
+	// none, all, or 3.
+	PubRMonths string `url:"pubrmonths,omitempty"`
+
+	// Set to true to show the overview page on public reports, or false to
+	// hide it. Left unset, the setting is not changed.
+	PubRShowOverview *bool `url:"pubrshowoverview,omitempty"`
+
+	// Set to true to serve public reports from a custom domain, or false to
+	// serve them from the Pingdom domain. Left unset, the setting is not
+	// changed.
+	PubRCustomDomain *bool `url:"pubrcustomdomain,omitempty"`
+}
+
+// Validate checks the region, time zone, format, country, and phone
+// settings against Pingdom reference data, as returned by
+// reference.GetReference. Only fields that are set are checked.
+func (in ModifyAccountSettingsInput) Validate(ref reference.GetReferenceOutput) error {
+	if in.RegionID != 0 && ref.ValidRegionID(in.RegionID) == false {
+		return fmt.Errorf("Region ID %d is not known to Pingdom", in.RegionID)
+	}
+	if in.TimeZoneID != 0 && ref.ValidTimeZoneID(in.TimeZoneID) == false {
+		return fmt.Errorf("Time zone ID %d is not known to Pingdom", in.TimeZoneID)
+	}
+	if in.DatetimeFormatID != 0 && ref.ValidDatetimeFormatID(in.DatetimeFormatID) == false {
+		return fmt.Errorf("Date/time format ID %d is not known to Pingdom", in.DatetimeFormatID)
+	}
+	if in.NumberFormatID != 0 && ref.ValidNumberFormatID(in.NumberFormatID) == false {
+		return fmt.Errorf("Number format ID %d is not known to Pingdom", in.NumberFormatID)
+	}
+	if in.CountryISO != "" && ref.ValidCountryISO(in.CountryISO) == false {
+		return fmt.Errorf("Country ISO code %s is not known to Pingdom", in.CountryISO)
+	}
+	if in.CellPhone != "" && in.CellCountryCode == "" && in.CellCountryISO == "" {
+		return fmt.Errorf("Country code and country ISO code are required with cell phone %s", in.CellPhone)
+	}
+	if in.CellCountryCode != "" || in.CellCountryISO != "" {
+		if err := ref.ValidatePhone(in.CellCountryCode, in.CellCountryISO); err != nil {
+			return err
+		}
+	}
+	if in.Phone != "" && in.PhoneCountryCode == "" && in.PhoneCountryISO == "" {
+		return fmt.Errorf("Country code and country ISO code are required with phone %s", in.Phone)
+	}
+	if in.PhoneCountryCode != "" || in.PhoneCountryISO != "" {
+		if err := ref.ValidatePhone(in.PhoneCountryCode, in.PhoneCountryISO); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ModifyAccountSettingsOutput contains the output for the
+// ModifyAccountSettings function.
+type ModifyAccountSettingsOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyAccountSettings modifies the settings for the Pingdom account.
+//
+// Only the provided settings are changed.
+func (c *Settings) ModifyAccountSettings(in ModifyAccountSettingsInput) (out ModifyAccountSettingsOutput, err error) {
+	err = c.sendRequest("PUT", "/api/2.0/settings", &in, &out)
+	return
+}
@@ -0,0 +1,403 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/resource/reference"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getAccountSettingsInputData() GetAccountSettingsInput {
+	return GetAccountSettingsInput{}
+}
+
+func getAccountSettingsOutputData() GetAccountSettingsOutput {
+	return GetAccountSettingsOutput{
+		Settings: SettingsEntry{
+			FirstName:           "John",
+			LastName:            "Doe",
+			Company:             "Example Inc",
+			Email:               "john@johnsdomain.com",
+			Phone:               "5555555",
+			PhoneCountryISO:     "SE",
+			CellPhone:           "5555556",
+			CellPhoneCountryISO: "SE",
+			Address:             "Main Street 1",
+			Zip:                 "11122",
+			Location:            "Stockholm",
+			Country: SettingsCountryEntry{
+				Name:      "Sweden",
+				ISO:       "SE",
+				CountryID: 205,
+			},
+			AutoLogout: true,
+			Region:     "Sweden (Stockholm)",
+			TimeZone: SettingsTimeZoneEntry{
+				ID:          44,
+				Description: "(GMT +01:00) Stockholm",
+			},
+			DatetimeFormat: "yyyy-mm-dd hh:mm:ss",
+			NumberFormat:   "123 456,00",
+			PublicReports: SettingsPublicReportsEntry{
+				CustomDesign:    true,
+				TextColor:       "000000",
+				BackgroundColor: "FFFFFF",
+				LogoURL:         "https://example.com/logo.png",
+				Months:          "3",
+				ShowOverview:    true,
+				CustomDomain:    false,
+			},
+		},
+	}
+}
+
+const getAccountSettingsOutputText = `
+{
+	"settings": {
+		"firstname": "John",
+		"lastname": "Doe",
+		"company": "Example Inc",
+		"email": "john@johnsdomain.com",
+		"phone": "5555555",
+		"phonecountryiso": "SE",
+		"cellphone": "5555556",
+		"cellphonecountryiso": "SE",
+		"address": "Main Street 1",
+		"address2": "",
+		"zip": "11122",
+		"location": "Stockholm",
+		"state": "",
+		"country": {
+			"name": "Sweden",
+			"iso": "SE",
+			"countryid": 205
+		},
+		"vatcode": "",
+		"autologout": true,
+		"region": "Sweden (Stockholm)",
+		"timezone": {
+			"id": 44,
+			"description": "(GMT +01:00) Stockholm"
+		},
+		"datetimeformat": "yyyy-mm-dd hh:mm:ss",
+		"numberformat": "123 456,00",
+		"publicreports": {
+			"customdesign": true,
+			"textcolor": "000000",
+			"backgroundcolor": "FFFFFF",
+			"logourl": "https://example.com/logo.png",
+			"months": "3",
+			"showoverview": true,
+			"customdomain": false
+		}
+	}
+}
+`
+
+func httpGetAccountSettingsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getAccountSettingsOutputText, http.StatusOK)
+	})
+}
+
+func modifyAccountSettingsInputData() ModifyAccountSettingsInput {
+	autoLogout := true
+	showOverview := true
+	return ModifyAccountSettingsInput{
+		Company:          "Example Inc",
+		CellPhone:        "5555556",
+		CellCountryCode:  "46",
+		CellCountryISO:   "SE",
+		CountryISO:       "SE",
+		AutoLogout:       &autoLogout,
+		RegionID:         1,
+		TimeZoneID:       44,
+		DatetimeFormatID: 2,
+		NumberFormatID:   3,
+		PubRMonths:       "3",
+		PubRShowOverview: &showOverview,
+	}
+}
+
+const modifyAccountSettingsInputText = "autologout=true&cellcountrycode=46&cellcountryiso=SE&cellphone=5555556&company=Example+Inc&countryiso=SE&datetimeformatid=2&numberformatid=3&pubrmonths=3&pubrshowoverview=true&regionid=1&timezoneid=44"
+
+func modifyAccountSettingsDisableInputData() ModifyAccountSettingsInput {
+	autoLogout := false
+	customDomain := false
+	return ModifyAccountSettingsInput{
+		AutoLogout:       &autoLogout,
+		PubRCustomDomain: &customDomain,
+	}
+}
+
+const modifyAccountSettingsDisableInputText = "autologout=false&pubrcustomdomain=false"
+
+func modifyAccountSettingsOutputData() ModifyAccountSettingsOutput {
+	return ModifyAccountSettingsOutput{
+		Message: "Settings updated successfully",
+	}
+}
+
+const modifyAccountSettingsOutputText = `
+{
+	"message": "Settings updated successfully"
+}
+`
+
+func httpModifyAccountSettingsTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyAccountSettingsOutputText, http.StatusOK)
+	})
+}
+
+func referenceData() reference.GetReferenceOutput {
+	return reference.GetReferenceOutput{
+		Regions:         []reference.RegionEntry{reference.RegionEntry{ID: 1}},
+		TimeZones:       []reference.TimeZoneEntry{reference.TimeZoneEntry{ID: 44}},
+		DatetimeFormats: []reference.DatetimeFormatEntry{reference.DatetimeFormatEntry{ID: 2}},
+		NumberFormats:   []reference.NumberFormatEntry{reference.NumberFormatEntry{ID: 3}},
+		Countries:       []reference.CountryEntry{reference.CountryEntry{ID: 205, ISO: "SE"}},
+		PhoneCodes:      []reference.PhoneCodeEntry{reference.PhoneCodeEntry{CountryID: 205, PhoneCode: "46"}},
+	}
+}
+
+func TestSettingsNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestSettingsNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetAccountSettings(t *testing.T) {
+	ts := httpGetAccountSettingsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAccountSettingsInputData()
+	out, err := c.GetAccountSettings(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getAccountSettingsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetAccountSettingsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getAccountSettingsInputData()
+	_, err := c.GetAccountSettings(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyAccountSettingsQueryText(t *testing.T) {
+	in := modifyAccountSettingsInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyAccountSettingsInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyAccountSettingsDisableQueryText(t *testing.T) {
+	in := modifyAccountSettingsDisableInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyAccountSettingsDisableInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyAccountSettings(t *testing.T) {
+	ts := httpModifyAccountSettingsTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyAccountSettingsInputData()
+	out, err := c.ModifyAccountSettings(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyAccountSettingsOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyAccountSettingsError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyAccountSettingsInputData()
+	_, err := c.ModifyAccountSettings(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyAccountSettingsInputValidate(t *testing.T) {
+	ref := referenceData()
+
+	in := modifyAccountSettingsInputData()
+	if err := in.Validate(ref); err != nil {
+		t.Fatalf("Unexpected validation error: %s", err)
+	}
+
+	in = modifyAccountSettingsInputData()
+	in.TimeZoneID = 45
+	if err := in.Validate(ref); err == nil || err.Error() != "Time zone ID 45 is not known to Pingdom" {
+		t.Fatalf("Expected time zone validation error, got %v", err)
+	}
+
+	in = modifyAccountSettingsInputData()
+	in.CellCountryCode = "1"
+	if err := in.Validate(ref); err == nil || err.Error() != "Phone code 1 is not known to Pingdom" {
+		t.Fatalf("Expected phone code validation error, got %v", err)
+	}
+
+	in = modifyAccountSettingsInputData()
+	in.CellCountryCode = ""
+	in.CellCountryISO = ""
+	if err := in.Validate(ref); err == nil || err.Error() != "Country code and country ISO code are required with cell phone 5555556" {
+		t.Fatalf("Expected missing cell phone country error, got %v", err)
+	}
+
+	in = modifyAccountSettingsInputData()
+	in.Phone = "5555557"
+	if err := in.Validate(ref); err == nil || err.Error() != "Country code and country ISO code are required with phone 5555557" {
+		t.Fatalf("Expected missing phone country error, got %v", err)
+	}
+}
+
+func TestSettingsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Account settings cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetAccountSettings(getAccountSettingsInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetAccountSettings to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.ModifyAccountSettings(modifyAccountSettingsInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected ModifyAccountSettings to fail with %s, got %v", expected, err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharedreports contains the methods necessary for managing shared
+// reports (uptime and response time banners) at Pingdom.
+//
+// Shared reports can only be managed with an email address, password, and
+// application key. All functions return an error when an API token is
+// configured.
+package sharedreports
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// SharedReport is the base client for shared report-related methods.
+type SharedReport struct {
+	client.Client
+}
+
+// New returns a new instance of the SharedReport API.
+func New(configs ...pingdom.Config) *SharedReport {
+	c := &SharedReport{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all shared report functions when using
+// an API token, as version 3.1 of the API has no shared reports endpoint.
+var errTokenNotSupported = errors.New("Shared reports cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *SharedReport) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// BannerEntry holds a single banner from SharedReportListEntry.
+type BannerEntry struct {
+	_ struct{}
+
+	// The banner identifier.
+	ID string
+
+	// The ID of the check the banner is for.
+	CheckID int
+
+	// yes if the banner period is automatic, otherwise no.
+	Auto string
+
+	// The banner type. One of uptime or response.
+	Type string
+
+	// The URL of the banner.
+	URL string
+
+	// The start year of the banner period, if not automatic.
+	FromYear int
+
+	// The start month of the banner period, if not automatic.
+	FromMonth int
+
+	// The start day of the banner period, if not automatic.
+	FromDay int
+
+	// The end year of the banner period, if not automatic.
+	ToYear int
+
+	// The end month of the banner period, if not automatic.
+	ToMonth int
+
+	// The end day of the banner period, if not automatic.
+	ToDay int
+}
+
+// SharedReportListEntry holds the shared reports from
+// GetSharedReportListOutput.
+type SharedReportListEntry struct {
+	_ struct{}
+
+	// The list of banners.
+	Banners []BannerEntry
+}
+
+// GetSharedReportListInput contains the input to send to the
+// GetSharedReportList function. The shared report list endpoint takes no
+// parameters.
+type GetSharedReportListInput struct {
+	_ struct{}
+}
+
+// GetSharedReportListOutput contains the output for the GetSharedReportList
+// function.
+type GetSharedReportListOutput struct {
+	_ struct{}
+
+	// The shared reports.
+	Shared SharedReportListEntry
+}
+
+// GetSharedReportList gets a list of all shared reports (banners).
+func (c *SharedReport) GetSharedReportList(in GetSharedReportListInput) (out GetSharedReportListOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.0/reports.shared", &in, &out)
+	return
+}
+
+// CreateSharedReportInput contains the input for the CreateSharedReport
+// function.
+type CreateSharedReportInput struct {
+	_ struct{}
+
+	// The shared report type. Only banner is supported, and is used if this
+	// is left unset.
+	SharedType string `url:"sharedtype"`
+
+	// The ID of the check to create the banner for.
+	CheckID int `url:"checkid"`
+
+	// Set to false to use the From and To fields for the banner period.
+	// Defaults to true, which makes the period automatic.
+	Auto *bool `url:"auto,omitempty"`
+
+	// The start year of the banner period. Requires Auto to be false.
+	FromYear int `url:"fromyear,omitempty"`
+
+	// The start month of the banner period. Requires Auto to be false.
+	FromMonth int `url:"frommonth,omitempty"`
+
+	// The start day of the banner period. Requires Auto to be false.
+	FromDay int `url:"fromday,omitempty"`
+
+	// The end year of the banner period. Requires Auto to be false.
+	ToYear int `url:"toyear,omitempty"`
+
+	// The end month of the banner period. Requires Auto to be false.
+	ToMonth int `url:"tomonth,omitempty"`
+
+	// The end day of the banner period. Requires Auto to be false.
+	ToDay int `url:"today,omitempty"`
+
+	// The banner type. One of uptime or response.
+	Type string `url:"type,omitempty"`
+}
+
+// CreateSharedReportEntry is the actual shared report data in the output of
+// CreateSharedReport.
+type CreateSharedReportEntry struct {
+	_ struct{}
+
+	// The ID of the banner that was created.
+	ID string
+}
+
+// CreateSharedReportOutput contains the output for the CreateSharedReport
+// function.
+type CreateSharedReportOutput struct {
+	_ struct{}
+
+	// The shared report data.
+	Shared CreateSharedReportEntry
+}
+
+// CreateSharedReport creates a shared report (banner). Use
+// GetSharedReportList to look up the URL of the new banner.
+func (c *SharedReport) CreateSharedReport(in CreateSharedReportInput) (out CreateSharedReportOutput, err error) {
+	if in.SharedType == "" {
+		in.SharedType = "banner"
+	}
+	err = c.sendRequest("POST", "/api/2.0/reports.shared", &in, &out)
+	return
+}
+
+// DeleteSharedReportInput contains the input for the DeleteSharedReport
+// function.
+type DeleteSharedReportInput struct {
+	_ struct{}
+
+	// The ID of the banner that you want to delete.
+	ReportID string
+}
+
+// DeleteSharedReportOutput contains the output for the DeleteSharedReport
+// function.
+type DeleteSharedReportOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteSharedReport deletes an existing shared report (banner).
+func (c *SharedReport) DeleteSharedReport(in DeleteSharedReportInput) (out DeleteSharedReportOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.0/reports.shared/%s", in.ReportID), nil, &out)
+	return
+}
@@ -0,0 +1,403 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharedreports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getSharedReportListInputData() GetSharedReportListInput {
+	return GetSharedReportListInput{}
+}
+
+func getSharedReportListOutputData() GetSharedReportListOutput {
+	return GetSharedReportListOutput{
+		Shared: SharedReportListEntry{
+			Banners: []BannerEntry{
+				BannerEntry{
+					ID:      "2nbIy3",
+					CheckID: 85975,
+					Auto:    "yes",
+					Type:    "uptime",
+					URL:     "http://share.pingdom.com/banners/2nbIy3",
+				},
+				BannerEntry{
+					ID:        "9gxSd2",
+					CheckID:   85975,
+					Auto:      "no",
+					Type:      "response",
+					URL:       "http://share.pingdom.com/banners/9gxSd2",
+					FromYear:  2016,
+					FromMonth: 1,
+					FromDay:   1,
+					ToYear:    2016,
+					ToMonth:   6,
+					ToDay:     30,
+				},
+			},
+		},
+	}
+}
+
+const getSharedReportListOutputText = `
+{
+	"shared": {
+		"banners": [{
+			"id": "2nbIy3",
+			"checkid": 85975,
+			"auto": "yes",
+			"type": "uptime",
+			"url": "http://share.pingdom.com/banners/2nbIy3"
+		}, {
+			"id": "9gxSd2",
+			"checkid": 85975,
+			"auto": "no",
+			"type": "response",
+			"url": "http://share.pingdom.com/banners/9gxSd2",
+			"fromyear": 2016,
+			"frommonth": 1,
+			"fromday": 1,
+			"toyear": 2016,
+			"tomonth": 6,
+			"today": 30
+		}]
+	}
+}
+`
+
+func httpGetSharedReportListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getSharedReportListOutputText, http.StatusOK)
+	})
+}
+
+func createSharedReportInputData() CreateSharedReportInput {
+	auto := false
+	return CreateSharedReportInput{
+		SharedType: "banner",
+		CheckID:    85975,
+		Auto:       &auto,
+		FromYear:   2016,
+		FromMonth:  1,
+		FromDay:    1,
+		ToYear:     2016,
+		ToMonth:    6,
+		ToDay:      30,
+		Type:       "response",
+	}
+}
+
+const createSharedReportInputText = "auto=false&checkid=85975&fromday=1&frommonth=1&fromyear=2016&sharedtype=banner&today=30&tomonth=6&toyear=2016&type=response"
+
+func createSharedReportOutputData() CreateSharedReportOutput {
+	return CreateSharedReportOutput{
+		Shared: CreateSharedReportEntry{
+			ID: "9gxSd2",
+		},
+	}
+}
+
+const createSharedReportOutputText = `
+{
+	"shared": {
+		"id": "9gxSd2"
+	}
+}
+`
+
+func httpCreateSharedReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createSharedReportOutputText, http.StatusOK)
+	})
+}
+
+func httpCreateSharedReportDefaultTypeTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("sharedtype") != "banner" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createSharedReportOutputText, http.StatusOK)
+	})
+}
+
+func deleteSharedReportInputData() DeleteSharedReportInput {
+	return DeleteSharedReportInput{
+		ReportID: "9gxSd2",
+	}
+}
+
+func deleteSharedReportOutputData() DeleteSharedReportOutput {
+	return DeleteSharedReportOutput{
+		Message: "Banner deleted",
+	}
+}
+
+const deleteSharedReportOutputText = `
+{
+	"message": "Banner deleted"
+}
+`
+
+func httpDeleteSharedReportTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteSharedReportOutputText, http.StatusOK)
+	})
+}
+
+func TestSharedReportNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestSharedReportNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetSharedReportList(t *testing.T) {
+	ts := httpGetSharedReportListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSharedReportListInputData()
+	out, err := c.GetSharedReportList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getSharedReportListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetSharedReportListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSharedReportListInputData()
+	_, err := c.GetSharedReportList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestCreateSharedReportQueryText(t *testing.T) {
+	in := createSharedReportInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := createSharedReportInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateSharedReport(t *testing.T) {
+	ts := httpCreateSharedReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createSharedReportInputData()
+	out, err := c.CreateSharedReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createSharedReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateSharedReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createSharedReportInputData()
+	_, err := c.CreateSharedReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestCreateSharedReportDefaultType(t *testing.T) {
+	ts := httpCreateSharedReportDefaultTypeTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createSharedReportInputData()
+	in.SharedType = ""
+	_, err := c.CreateSharedReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+}
+
+func TestDeleteSharedReport(t *testing.T) {
+	ts := httpDeleteSharedReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteSharedReportInputData()
+	out, err := c.DeleteSharedReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteSharedReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteSharedReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteSharedReportInputData()
+	_, err := c.DeleteSharedReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestSharedReportsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Shared reports cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetSharedReportList(getSharedReportListInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetSharedReportList to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.CreateSharedReport(createSharedReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected CreateSharedReport to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.DeleteSharedReport(deleteSharedReportInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected DeleteSharedReport to fail with %s, got %v", expected, err)
+	}
+}
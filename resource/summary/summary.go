@@ -0,0 +1,431 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package summary contains the methods necessary for retrieving summarized
+// check data (averages, outages, performance, etc) from Pingdom.
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Summary is the base client for summary-related methods.
+type Summary struct {
+	client.Client
+}
+
+// New returns a new instance of the Summary API.
+func New(configs ...pingdom.Config) *Summary {
+	c := &Summary{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// SummaryAverageCountryEntry holds the average response time for a single
+// country, returned by GetSummaryAverage when ByCountry is set.
+type SummaryAverageCountryEntry struct {
+	_ struct{}
+
+	// The country ISO code.
+	CountryISO string
+
+	// The average response time (in milliseconds).
+	AvgResponse int
+}
+
+// SummaryAverageProbeEntry holds the average response time for a single
+// probe, returned by GetSummaryAverage when ByProbe is set.
+type SummaryAverageProbeEntry struct {
+	_ struct{}
+
+	// The probe identifier.
+	ProbeID int
+
+	// The average response time (in milliseconds).
+	AvgResponse int
+}
+
+// SummaryAverageResponseTime contains the response time data returned by
+// GetSummaryAverage.
+type SummaryAverageResponseTime struct {
+	_ struct{}
+
+	// The start of the requested period (UNIX timestamp).
+	From int
+
+	// The end of the requested period (UNIX timestamp).
+	To int
+
+	// The average response time (in milliseconds). Only set when neither
+	// ByCountry or ByProbe were requested.
+	AvgResponse int
+
+	// The average response time per country. Only set when ByCountry was
+	// requested.
+	AvgResponseByCountry []SummaryAverageCountryEntry
+
+	// The average response time per probe. Only set when ByProbe was
+	// requested.
+	AvgResponseByProbe []SummaryAverageProbeEntry
+}
+
+// UnmarshalJSON implements json.Unmarshaler for SummaryAverageResponseTime.
+//
+// The API returns avgresponse as either a single number or a list of
+// per-country or per-probe entries, depending on the request parameters.
+// This sorts the value into the matching field.
+func (r *SummaryAverageResponseTime) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		From        int
+		To          int
+		AvgResponse json.RawMessage
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	r.From = raw.From
+	r.To = raw.To
+
+	if len(raw.AvgResponse) == 0 {
+		return nil
+	}
+	if raw.AvgResponse[0] != '[' {
+		return json.Unmarshal(raw.AvgResponse, &r.AvgResponse)
+	}
+
+	var entries []struct {
+		CountryISO  string
+		ProbeID     int
+		AvgResponse int
+	}
+	if err := json.Unmarshal(raw.AvgResponse, &entries); err != nil {
+		return err
+	}
+	for _, v := range entries {
+		if v.CountryISO != "" {
+			r.AvgResponseByCountry = append(r.AvgResponseByCountry, SummaryAverageCountryEntry{
+				CountryISO:  v.CountryISO,
+				AvgResponse: v.AvgResponse,
+			})
+			continue
+		}
+		r.AvgResponseByProbe = append(r.AvgResponseByProbe, SummaryAverageProbeEntry{
+			ProbeID:     v.ProbeID,
+			AvgResponse: v.AvgResponse,
+		})
+	}
+	return nil
+}
+
+// SummaryAverageStatus contains the uptime data returned by
+// GetSummaryAverage when IncludeUptime is set.
+type SummaryAverageStatus struct {
+	_ struct{}
+
+	// Total uptime (in seconds).
+	TotalUp int
+
+	// Total downtime (in seconds).
+	TotalDown int
+
+	// Total unmonitored time (in seconds).
+	TotalUnknown int
+}
+
+// SummaryAverageEntry contains the summary data returned by
+// GetSummaryAverage.
+type SummaryAverageEntry struct {
+	_ struct{}
+
+	// The response time summary.
+	ResponseTime SummaryAverageResponseTime
+
+	// The uptime summary. Only set when IncludeUptime was requested.
+	Status SummaryAverageStatus
+}
+
+// GetSummaryAverageInput contains the input to send to the
+// GetSummaryAverage function.
+type GetSummaryAverageInput struct {
+	_ struct{}
+
+	// The ID of the check to summarize.
+	CheckID int `url:"-"`
+
+	// The start of the period to summarize (UNIX timestamp). Defaults to
+	// the creation time of the check.
+	From int `url:"from,omitempty"`
+
+	// The end of the period to summarize (UNIX timestamp). Defaults to the
+	// current time.
+	To int `url:"to,omitempty"`
+
+	// Filter the summary to a list of probe IDs.
+	Probes []int `url:"probes,comma,omitempty"`
+
+	// Include uptime information.
+	IncludeUptime bool `url:"includeuptime,omitempty"`
+
+	// Split the response times into country groups.
+	ByCountry bool `url:"bycountry,omitempty"`
+
+	// Split the response times into probe groups.
+	ByProbe bool `url:"byprobe,omitempty"`
+}
+
+// GetSummaryAverageOutput contains the output for the GetSummaryAverage
+// function.
+type GetSummaryAverageOutput struct {
+	_ struct{}
+
+	// The summary data.
+	Summary SummaryAverageEntry
+}
+
+// GetSummaryAverage gets the average response time and uptime for a specific
+// check.
+func (c *Summary) GetSummaryAverage(in GetSummaryAverageInput) (out GetSummaryAverageOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/summary.average/%d", in.CheckID), &in, &out)
+	return
+}
+
+// SummaryHourOfDayEntry holds a single hour from GetSummaryHoursOfDayOutput.
+type SummaryHourOfDayEntry struct {
+	_ struct{}
+
+	// The hour of the day (0-23).
+	Hour int
+
+	// The average response time (in milliseconds) for this hour of the day.
+	AvgResponse int
+}
+
+// GetSummaryHoursOfDayInput contains the input to send to the
+// GetSummaryHoursOfDay function.
+type GetSummaryHoursOfDayInput struct {
+	_ struct{}
+
+	// The ID of the check to summarize.
+	CheckID int `url:"-"`
+
+	// The start of the period to summarize (UNIX timestamp). Defaults to one
+	// week prior to To.
+	From int `url:"from,omitempty"`
+
+	// The end of the period to summarize (UNIX timestamp). Defaults to the
+	// current time.
+	To int `url:"to,omitempty"`
+
+	// Filter the summary to a list of probe IDs.
+	Probes []int `url:"probes,comma,omitempty"`
+
+	// Use the local time of the account instead of UTC for the hours.
+	UseLocalTime bool `url:"uselocaltime,omitempty"`
+}
+
+// GetSummaryHoursOfDayOutput contains the output for the
+// GetSummaryHoursOfDay function.
+type GetSummaryHoursOfDayOutput struct {
+	_ struct{}
+
+	// The average response time for each hour of the day.
+	HoursOfDay []SummaryHourOfDayEntry
+}
+
+// GetSummaryHoursOfDay gets the average response time for each hour of the
+// day for a specific check.
+func (c *Summary) GetSummaryHoursOfDay(in GetSummaryHoursOfDayInput) (out GetSummaryHoursOfDayOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/summary.hoursofday/%d", in.CheckID), &in, &out)
+	return
+}
+
+// SummaryOutageStateEntry holds a single state change from
+// GetSummaryOutageOutput.
+type SummaryOutageStateEntry struct {
+	_ struct{}
+
+	// The check status during this interval. One of up, down, or unknown.
+	Status string
+
+	// The start of the interval (UNIX timestamp).
+	TimeFrom int
+
+	// The end of the interval (UNIX timestamp).
+	TimeTo int
+}
+
+// SummaryOutageEntry contains the summary data returned by GetSummaryOutage.
+type SummaryOutageEntry struct {
+	_ struct{}
+
+	// The list of status intervals.
+	States []SummaryOutageStateEntry
+}
+
+// GetSummaryOutageInput contains the input to send to the GetSummaryOutage
+// function.
+type GetSummaryOutageInput struct {
+	_ struct{}
+
+	// The ID of the check to summarize.
+	CheckID int `url:"-"`
+
+	// The start of the period to summarize (UNIX timestamp). Defaults to one
+	// week prior to To.
+	From int `url:"from,omitempty"`
+
+	// The end of the period to summarize (UNIX timestamp). Defaults to the
+	// current time.
+	To int `url:"to,omitempty"`
+
+	// The sort order of the intervals. One of asc or desc.
+	Order string `url:"order,omitempty"`
+}
+
+// GetSummaryOutageOutput contains the output for the GetSummaryOutage
+// function.
+type GetSummaryOutageOutput struct {
+	_ struct{}
+
+	// The summary data.
+	Summary SummaryOutageEntry
+}
+
+// GetSummaryOutage gets the list of status changes for a specific check.
+func (c *Summary) GetSummaryOutage(in GetSummaryOutageInput) (out GetSummaryOutageOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/summary.outage/%d", in.CheckID), &in, &out)
+	return
+}
+
+// SummaryPerformanceIntervalEntry holds a single interval from
+// GetSummaryPerformanceOutput.
+type SummaryPerformanceIntervalEntry struct {
+	_ struct{}
+
+	// The start of the interval (UNIX timestamp).
+	StartTime int
+
+	// The average response time (in milliseconds) for the interval.
+	AvgResponse int
+
+	// Total uptime (in seconds). Only set when IncludeUptime was requested.
+	Uptime int
+
+	// Total downtime (in seconds). Only set when IncludeUptime was
+	// requested.
+	Downtime int
+
+	// Total unmonitored time (in seconds). Only set when IncludeUptime was
+	// requested.
+	Unmonitored int
+}
+
+// SummaryPerformanceEntry contains the summary data returned by
+// GetSummaryPerformance. Only the field matching the requested Resolution
+// will be populated.
+type SummaryPerformanceEntry struct {
+	_ struct{}
+
+	// Intervals for the hour resolution.
+	Hours []SummaryPerformanceIntervalEntry
+
+	// Intervals for the day resolution.
+	Days []SummaryPerformanceIntervalEntry
+
+	// Intervals for the week resolution.
+	Weeks []SummaryPerformanceIntervalEntry
+}
+
+// GetSummaryPerformanceInput contains the input to send to the
+// GetSummaryPerformance function.
+type GetSummaryPerformanceInput struct {
+	_ struct{}
+
+	// The ID of the check to summarize.
+	CheckID int `url:"-"`
+
+	// The start of the period to summarize (UNIX timestamp). Defaults to the
+	// resolution-dependent maximum period prior to To.
+	From int `url:"from,omitempty"`
+
+	// The end of the period to summarize (UNIX timestamp). Defaults to the
+	// current time.
+	To int `url:"to,omitempty"`
+
+	// The interval size. One of hour, day, or week. Defaults to hour.
+	Resolution string `url:"resolution,omitempty"`
+
+	// Include uptime information.
+	IncludeUptime bool `url:"includeuptime,omitempty"`
+
+	// Filter the summary to a list of probe IDs. Cannot be used with
+	// IncludeUptime.
+	Probes []int `url:"probes,comma,omitempty"`
+
+	// The sort order of the intervals. One of asc or desc.
+	Order string `url:"order,omitempty"`
+}
+
+// GetSummaryPerformanceOutput contains the output for the
+// GetSummaryPerformance function.
+type GetSummaryPerformanceOutput struct {
+	_ struct{}
+
+	// The summary data.
+	Summary SummaryPerformanceEntry
+}
+
+// GetSummaryPerformance gets the response time and uptime for a specific
+// check, grouped into hour, day, or week intervals.
+func (c *Summary) GetSummaryPerformance(in GetSummaryPerformanceInput) (out GetSummaryPerformanceOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/summary.performance/%d", in.CheckID), &in, &out)
+	return
+}
+
+// GetSummaryProbesInput contains the input to send to the GetSummaryProbes
+// function.
+type GetSummaryProbesInput struct {
+	_ struct{}
+
+	// The ID of the check to summarize.
+	CheckID int `url:"-"`
+
+	// The start of the period to summarize (UNIX timestamp).
+	From int `url:"from"`
+
+	// The end of the period to summarize (UNIX timestamp). Defaults to the
+	// current time.
+	To int `url:"to,omitempty"`
+}
+
+// GetSummaryProbesOutput contains the output for the GetSummaryProbes
+// function.
+type GetSummaryProbesOutput struct {
+	_ struct{}
+
+	// The IDs of the probes that ran tests for the check.
+	Probes []int
+}
+
+// GetSummaryProbes gets the list of probes that ran tests for a specific
+// check during a period of time.
+func (c *Summary) GetSummaryProbes(in GetSummaryProbesInput) (out GetSummaryProbesOutput, err error) {
+	err = c.SendRequest("GET", fmt.Sprintf("/api/2.0/summary.probes/%d", in.CheckID), &in, &out)
+	return
+}
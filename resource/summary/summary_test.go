@@ -0,0 +1,680 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func getSummaryAverageInputData() GetSummaryAverageInput {
+	return GetSummaryAverageInput{
+		CheckID:       85975,
+		From:          1294235000,
+		To:            1294239000,
+		Probes:        []int{33, 34},
+		IncludeUptime: true,
+	}
+}
+
+const getSummaryAverageInputText = "from=1294235000&includeuptime=true&probes=33%2C34&to=1294239000"
+
+func getSummaryAverageOutputData() GetSummaryAverageOutput {
+	return GetSummaryAverageOutput{
+		Summary: SummaryAverageEntry{
+			ResponseTime: SummaryAverageResponseTime{
+				From:        1294235000,
+				To:          1294239000,
+				AvgResponse: 337,
+			},
+			Status: SummaryAverageStatus{
+				TotalUp:      3600,
+				TotalDown:    300,
+				TotalUnknown: 100,
+			},
+		},
+	}
+}
+
+const getSummaryAverageOutputText = `
+{
+	"summary": {
+		"responsetime": {
+			"from": 1294235000,
+			"to": 1294239000,
+			"avgresponse": 337
+		},
+		"status": {
+			"totalup": 3600,
+			"totaldown": 300,
+			"totalunknown": 100
+		}
+	}
+}
+`
+
+func getSummaryAverageByCountryOutputData() GetSummaryAverageOutput {
+	return GetSummaryAverageOutput{
+		Summary: SummaryAverageEntry{
+			ResponseTime: SummaryAverageResponseTime{
+				From: 1294235000,
+				To:   1294239000,
+				AvgResponseByCountry: []SummaryAverageCountryEntry{
+					SummaryAverageCountryEntry{
+						CountryISO:  "US",
+						AvgResponse: 353,
+					},
+					SummaryAverageCountryEntry{
+						CountryISO:  "GB",
+						AvgResponse: 318,
+					},
+				},
+			},
+		},
+	}
+}
+
+const getSummaryAverageByCountryOutputText = `
+{
+	"summary": {
+		"responsetime": {
+			"from": 1294235000,
+			"to": 1294239000,
+			"avgresponse": [{
+				"countryiso": "US",
+				"avgresponse": 353
+			}, {
+				"countryiso": "GB",
+				"avgresponse": 318
+			}]
+		}
+	}
+}
+`
+
+func getSummaryAverageByProbeOutputData() GetSummaryAverageOutput {
+	return GetSummaryAverageOutput{
+		Summary: SummaryAverageEntry{
+			ResponseTime: SummaryAverageResponseTime{
+				From: 1294235000,
+				To:   1294239000,
+				AvgResponseByProbe: []SummaryAverageProbeEntry{
+					SummaryAverageProbeEntry{
+						ProbeID:     33,
+						AvgResponse: 353,
+					},
+					SummaryAverageProbeEntry{
+						ProbeID:     34,
+						AvgResponse: 318,
+					},
+				},
+			},
+		},
+	}
+}
+
+const getSummaryAverageByProbeOutputText = `
+{
+	"summary": {
+		"responsetime": {
+			"from": 1294235000,
+			"to": 1294239000,
+			"avgresponse": [{
+				"probeid": 33,
+				"avgresponse": 353
+			}, {
+				"probeid": 34,
+				"avgresponse": 318
+			}]
+		}
+	}
+}
+`
+
+func httpGetSummaryAverageTestServer(text string) *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, text, http.StatusOK)
+	})
+}
+
+func getSummaryHoursOfDayInputData() GetSummaryHoursOfDayInput {
+	return GetSummaryHoursOfDayInput{
+		CheckID:      85975,
+		From:         1294235000,
+		Probes:       []int{33},
+		UseLocalTime: true,
+	}
+}
+
+const getSummaryHoursOfDayInputText = "from=1294235000&probes=33&uselocaltime=true"
+
+func getSummaryHoursOfDayOutputData() GetSummaryHoursOfDayOutput {
+	return GetSummaryHoursOfDayOutput{
+		HoursOfDay: []SummaryHourOfDayEntry{
+			SummaryHourOfDayEntry{
+				Hour:        0,
+				AvgResponse: 778,
+			},
+			SummaryHourOfDayEntry{
+				Hour:        1,
+				AvgResponse: 801,
+			},
+		},
+	}
+}
+
+const getSummaryHoursOfDayOutputText = `
+{
+	"hoursofday": [{
+		"hour": 0,
+		"avgresponse": 778
+	}, {
+		"hour": 1,
+		"avgresponse": 801
+	}]
+}
+`
+
+func httpGetSummaryHoursOfDayTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getSummaryHoursOfDayOutputText, http.StatusOK)
+	})
+}
+
+func getSummaryOutageInputData() GetSummaryOutageInput {
+	return GetSummaryOutageInput{
+		CheckID: 85975,
+		From:    1293143000,
+		To:      1294180000,
+		Order:   "asc",
+	}
+}
+
+const getSummaryOutageInputText = "from=1293143000&order=asc&to=1294180000"
+
+func getSummaryOutageOutputData() GetSummaryOutageOutput {
+	return GetSummaryOutageOutput{
+		Summary: SummaryOutageEntry{
+			States: []SummaryOutageStateEntry{
+				SummaryOutageStateEntry{
+					Status:   "up",
+					TimeFrom: 1293143000,
+					TimeTo:   1294180494,
+				},
+				SummaryOutageStateEntry{
+					Status:   "down",
+					TimeFrom: 1294180494,
+					TimeTo:   1294180584,
+				},
+			},
+		},
+	}
+}
+
+const getSummaryOutageOutputText = `
+{
+	"summary": {
+		"states": [{
+			"status": "up",
+			"timefrom": 1293143000,
+			"timeto": 1294180494
+		}, {
+			"status": "down",
+			"timefrom": 1294180494,
+			"timeto": 1294180584
+		}]
+	}
+}
+`
+
+func httpGetSummaryOutageTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getSummaryOutageOutputText, http.StatusOK)
+	})
+}
+
+func getSummaryPerformanceInputData() GetSummaryPerformanceInput {
+	return GetSummaryPerformanceInput{
+		CheckID:       85975,
+		From:          1293143000,
+		To:            1294180000,
+		Resolution:    "day",
+		IncludeUptime: true,
+		Order:         "desc",
+	}
+}
+
+const getSummaryPerformanceInputText = "from=1293143000&includeuptime=true&order=desc&resolution=day&to=1294180000"
+
+func getSummaryPerformanceOutputData() GetSummaryPerformanceOutput {
+	return GetSummaryPerformanceOutput{
+		Summary: SummaryPerformanceEntry{
+			Days: []SummaryPerformanceIntervalEntry{
+				SummaryPerformanceIntervalEntry{
+					StartTime:   1293145200,
+					AvgResponse: 1098,
+					Uptime:      86400,
+					Downtime:    0,
+					Unmonitored: 0,
+				},
+				SummaryPerformanceIntervalEntry{
+					StartTime:   1293231600,
+					AvgResponse: 1201,
+					Uptime:      86100,
+					Downtime:    300,
+					Unmonitored: 0,
+				},
+			},
+		},
+	}
+}
+
+const getSummaryPerformanceOutputText = `
+{
+	"summary": {
+		"days": [{
+			"starttime": 1293145200,
+			"avgresponse": 1098,
+			"uptime": 86400,
+			"downtime": 0,
+			"unmonitored": 0
+		}, {
+			"starttime": 1293231600,
+			"avgresponse": 1201,
+			"uptime": 86100,
+			"downtime": 300,
+			"unmonitored": 0
+		}]
+	}
+}
+`
+
+func httpGetSummaryPerformanceTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getSummaryPerformanceOutputText, http.StatusOK)
+	})
+}
+
+func getSummaryProbesInputData() GetSummaryProbesInput {
+	return GetSummaryProbesInput{
+		CheckID: 85975,
+		From:    1293143000,
+	}
+}
+
+const getSummaryProbesInputText = "from=1293143000"
+
+func getSummaryProbesOutputData() GetSummaryProbesOutput {
+	return GetSummaryProbesOutput{
+		Probes: []int{34, 35, 36, 37},
+	}
+}
+
+const getSummaryProbesOutputText = `
+{
+	"probes": [34, 35, 36, 37]
+}
+`
+
+func httpGetSummaryProbesTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getSummaryProbesOutputText, http.StatusOK)
+	})
+}
+
+func TestSummaryNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestSummaryNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetSummaryAverageQueryText(t *testing.T) {
+	in := getSummaryAverageInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getSummaryAverageInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetSummaryAverage(t *testing.T) {
+	cases := []struct {
+		Text     string
+		Expected GetSummaryAverageOutput
+	}{
+		{Text: getSummaryAverageOutputText, Expected: getSummaryAverageOutputData()},
+		{Text: getSummaryAverageByCountryOutputText, Expected: getSummaryAverageByCountryOutputData()},
+		{Text: getSummaryAverageByProbeOutputText, Expected: getSummaryAverageByProbeOutputData()},
+	}
+
+	for _, tc := range cases {
+		ts := httpGetSummaryAverageTestServer(tc.Text)
+		cfg := pingdomConfig()
+		cfg.Endpoint = ts.URL
+		c := New(cfg)
+		in := getSummaryAverageInputData()
+		out, err := c.GetSummaryAverage(in)
+		ts.Close()
+
+		if err != nil {
+			t.Fatalf("Unexpected request error: %s", err)
+		}
+
+		if reflect.DeepEqual(tc.Expected, out) == false {
+			t.Fatalf("expected %v, got %v", tc.Expected, out)
+		}
+	}
+}
+
+func TestGetSummaryAverageError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryAverageInputData()
+	_, err := c.GetSummaryAverage(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetSummaryHoursOfDayQueryText(t *testing.T) {
+	in := getSummaryHoursOfDayInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getSummaryHoursOfDayInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetSummaryHoursOfDay(t *testing.T) {
+	ts := httpGetSummaryHoursOfDayTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryHoursOfDayInputData()
+	out, err := c.GetSummaryHoursOfDay(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getSummaryHoursOfDayOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetSummaryHoursOfDayError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryHoursOfDayInputData()
+	_, err := c.GetSummaryHoursOfDay(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetSummaryOutageQueryText(t *testing.T) {
+	in := getSummaryOutageInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getSummaryOutageInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetSummaryOutage(t *testing.T) {
+	ts := httpGetSummaryOutageTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryOutageInputData()
+	out, err := c.GetSummaryOutage(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getSummaryOutageOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetSummaryOutageError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryOutageInputData()
+	_, err := c.GetSummaryOutage(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetSummaryPerformanceQueryText(t *testing.T) {
+	in := getSummaryPerformanceInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getSummaryPerformanceInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetSummaryPerformance(t *testing.T) {
+	ts := httpGetSummaryPerformanceTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryPerformanceInputData()
+	out, err := c.GetSummaryPerformance(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getSummaryPerformanceOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetSummaryPerformanceError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryPerformanceInputData()
+	_, err := c.GetSummaryPerformance(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetSummaryProbesQueryText(t *testing.T) {
+	in := getSummaryProbesInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getSummaryProbesInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetSummaryProbes(t *testing.T) {
+	ts := httpGetSummaryProbesTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryProbesInputData()
+	out, err := c.GetSummaryProbes(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getSummaryProbesOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetSummaryProbesError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getSummaryProbesInputData()
+	_, err := c.GetSummaryProbes(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package teams contains the methods necessary for managing alerting teams
+// at Pingdom.
+//
+// Teams can only be managed with an email address, password, and application
+// key. All functions return an error when an API token is configured.
+package teams
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Team is the base client for team-related methods.
+type Team struct {
+	client.Client
+}
+
+// New returns a new instance of the Team API.
+func New(configs ...pingdom.Config) *Team {
+	c := &Team{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all team functions when using an API
+// token, as the version 3.1 alerting teams take different request and
+// response data.
+var errTokenNotSupported = errors.New("Teams cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *Team) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// TeamMemberEntry holds a single member of a team.
+type TeamMemberEntry struct {
+	_ struct{}
+
+	// The ID of the user or contact.
+	ID int
+
+	// The name of the user or contact.
+	Name string
+
+	// The email address of the user or contact.
+	Email string
+}
+
+// TeamEntry holds a single team, as returned by GetTeamList and
+// GetDetailedTeam.
+type TeamEntry struct {
+	_ struct{}
+
+	// The team identifier.
+	ID int
+
+	// The team name.
+	Name string
+
+	// The members of the team.
+	Users []TeamMemberEntry
+}
+
+// GetTeamListInput contains the input to send to the GetTeamList function.
+// The team list endpoint takes no parameters.
+type GetTeamListInput struct {
+	_ struct{}
+}
+
+// GetTeamListOutput contains the output for the GetTeamList function.
+type GetTeamListOutput struct {
+	_ struct{}
+
+	// The list of teams.
+	Teams []TeamEntry
+}
+
+// GetTeamList gets a list of all teams.
+func (c *Team) GetTeamList(in GetTeamListInput) (out GetTeamListOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.0/teams", &in, &out)
+	return
+}
+
+// GetDetailedTeamInput contains the input to send to the GetDetailedTeam
+// function.
+type GetDetailedTeamInput struct {
+	_ struct{}
+
+	// The ID of the team to get details for.
+	TeamID int
+}
+
+// GetDetailedTeamOutput contains the output for the GetDetailedTeam function.
+type GetDetailedTeamOutput struct {
+	_ struct{}
+
+	// The team.
+	Team TeamEntry
+}
+
+// GetDetailedTeam gets detailed information about a single team.
+func (c *Team) GetDetailedTeam(in GetDetailedTeamInput) (out GetDetailedTeamOutput, err error) {
+	err = c.sendRequest("GET", fmt.Sprintf("/api/2.0/teams/%d", in.TeamID), nil, &out)
+	return
+}
+
+// TeamConfiguration is the structure for the CreateTeam and ModifyTeam
+// functions.
+type TeamConfiguration struct {
+	_ struct{}
+
+	// The team name.
+	Name string `url:"name,omitempty"`
+
+	// The IDs of the users or contacts that are members of the team.
+	UserIDs []int `url:"userids,comma,omitempty"`
+}
+
+// CreateTeamInput contains the input for the CreateTeam function.
+type CreateTeamInput struct {
+	_ struct{}
+
+	TeamConfiguration
+}
+
+// CreateTeamEntry is the actual team data in the output of CreateTeam.
+type CreateTeamEntry struct {
+	_ struct{}
+
+	// The ID of the team that was created.
+	ID int
+
+	// The name of the team that was created.
+	Name string
+}
+
+// CreateTeamOutput contains the output for the CreateTeam function.
+type CreateTeamOutput struct {
+	_ struct{}
+
+	// The team data.
+	Team CreateTeamEntry
+}
+
+// CreateTeam creates a team.
+func (c *Team) CreateTeam(in CreateTeamInput) (out CreateTeamOutput, err error) {
+	err = c.sendRequest("POST", "/api/2.0/teams", &in, &out)
+	return
+}
+
+// ModifyTeamInput contains the input for the ModifyTeam function.
+type ModifyTeamInput struct {
+	_ struct{}
+
+	// The ID of the team to modify.
+	TeamID int `url:"-"`
+
+	// The replacement team configuration. Note that UserIDs replaces the
+	// entire member list.
+	TeamConfiguration
+}
+
+// ModifyTeamOutput contains the output for the ModifyTeam function.
+type ModifyTeamOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyTeam modifies an existing team.
+func (c *Team) ModifyTeam(in ModifyTeamInput) (out ModifyTeamOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.0/teams/%d", in.TeamID), &in, &out)
+	return
+}
+
+// DeleteTeamInput contains the input for the DeleteTeam function.
+type DeleteTeamInput struct {
+	_ struct{}
+
+	// The ID of the team that you want to delete.
+	TeamID int
+}
+
+// DeleteTeamOutput contains the output for the DeleteTeam function.
+type DeleteTeamOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteTeam deletes an existing team from Pingdom.
+func (c *Team) DeleteTeam(in DeleteTeamInput) (out DeleteTeamOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.0/teams/%d", in.TeamID), nil, &out)
+	return
+}
@@ -0,0 +1,560 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teams
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/integration"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func teamEntryData() TeamEntry {
+	return TeamEntry{
+		ID:   1,
+		Name: "Operations",
+		Users: []TeamMemberEntry{
+			TeamMemberEntry{
+				ID:    111250,
+				Name:  "John Doe",
+				Email: "john@johnsdomain.com",
+			},
+			TeamMemberEntry{
+				ID:    111251,
+				Name:  "Jane Doe",
+				Email: "jane@janesdomain.com",
+			},
+		},
+	}
+}
+
+const teamEntryText = `
+{
+	"id": 1,
+	"name": "Operations",
+	"users": [{
+		"id": 111250,
+		"name": "John Doe",
+		"email": "john@johnsdomain.com"
+	}, {
+		"id": 111251,
+		"name": "Jane Doe",
+		"email": "jane@janesdomain.com"
+	}]
+}
+`
+
+func getTeamListInputData() GetTeamListInput {
+	return GetTeamListInput{}
+}
+
+func getTeamListOutputData() GetTeamListOutput {
+	return GetTeamListOutput{
+		Teams: []TeamEntry{teamEntryData()},
+	}
+}
+
+const getTeamListOutputText = `{"teams": [` + teamEntryText + `]}`
+
+func httpGetTeamListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getTeamListOutputText, http.StatusOK)
+	})
+}
+
+func getDetailedTeamInputData() GetDetailedTeamInput {
+	return GetDetailedTeamInput{
+		TeamID: 1,
+	}
+}
+
+func getDetailedTeamOutputData() GetDetailedTeamOutput {
+	return GetDetailedTeamOutput{
+		Team: teamEntryData(),
+	}
+}
+
+const getDetailedTeamOutputText = `{"team": ` + teamEntryText + `}`
+
+func httpGetDetailedTeamTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getDetailedTeamOutputText, http.StatusOK)
+	})
+}
+
+func teamConfigurationData() TeamConfiguration {
+	return TeamConfiguration{
+		Name:    "Operations",
+		UserIDs: []int{111250, 111251},
+	}
+}
+
+const teamConfigurationText = "name=Operations&userids=111250%2C111251"
+
+func createTeamInputData() CreateTeamInput {
+	return CreateTeamInput{
+		TeamConfiguration: teamConfigurationData(),
+	}
+}
+
+func createTeamOutputData() CreateTeamOutput {
+	return CreateTeamOutput{
+		Team: CreateTeamEntry{
+			ID:   1,
+			Name: "Operations",
+		},
+	}
+}
+
+const createTeamOutputText = `
+{
+	"team": {
+		"id": 1,
+		"name": "Operations"
+	}
+}
+`
+
+func httpCreateTeamTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createTeamOutputText, http.StatusOK)
+	})
+}
+
+func modifyTeamInputData() ModifyTeamInput {
+	return ModifyTeamInput{
+		TeamID:            1,
+		TeamConfiguration: teamConfigurationData(),
+	}
+}
+
+func modifyTeamOutputData() ModifyTeamOutput {
+	return ModifyTeamOutput{
+		Message: "Team successfully updated",
+	}
+}
+
+const modifyTeamOutputText = `
+{
+	"message": "Team successfully updated"
+}
+`
+
+func httpModifyTeamTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyTeamOutputText, http.StatusOK)
+	})
+}
+
+func deleteTeamInputData() DeleteTeamInput {
+	return DeleteTeamInput{
+		TeamID: 1,
+	}
+}
+
+func deleteTeamOutputData() DeleteTeamOutput {
+	return DeleteTeamOutput{
+		Message: "Team successfully deleted",
+	}
+}
+
+const deleteTeamOutputText = `
+{
+	"message": "Team successfully deleted"
+}
+`
+
+func httpDeleteTeamTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteTeamOutputText, http.StatusOK)
+	})
+}
+
+func TestTeamNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestTeamNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetTeamList(t *testing.T) {
+	ts := httpGetTeamListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTeamListInputData()
+	out, err := c.GetTeamList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getTeamListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetTeamListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTeamListInputData()
+	_, err := c.GetTeamList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetDetailedTeam(t *testing.T) {
+	ts := httpGetDetailedTeamTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedTeamInputData()
+	out, err := c.GetDetailedTeam(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getDetailedTeamOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetDetailedTeamError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedTeamInputData()
+	_, err := c.GetDetailedTeam(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestTeamConfigurationQueryText(t *testing.T) {
+	in := createTeamInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := teamConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateTeam(t *testing.T) {
+	ts := httpCreateTeamTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createTeamInputData()
+	out, err := c.CreateTeam(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createTeamOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateTeamError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createTeamInputData()
+	_, err := c.CreateTeam(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyTeam(t *testing.T) {
+	ts := httpModifyTeamTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyTeamInputData()
+	out, err := c.ModifyTeam(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyTeamOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyTeamError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyTeamInputData()
+	_, err := c.ModifyTeam(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	ts := httpDeleteTeamTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteTeamInputData()
+	out, err := c.DeleteTeam(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteTeamOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteTeamError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteTeamInputData()
+	_, err := c.DeleteTeam(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestTeamsTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Teams cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetTeamList(getTeamListInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetTeamList to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.CreateTeam(createTeamInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected CreateTeam to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.DeleteTeam(deleteTeamInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected DeleteTeam to fail with %s, got %v", expected, err)
+	}
+}
+
+// testAccTeamsCRUDCreate runs the Create section of the CRUD test
+// (using CreateTeam).
+func testAccTeamsCRUDCreate(t *testing.T, in CreateTeamInput) int {
+	c := New()
+	out, err := c.CreateTeam(in)
+	if err != nil {
+		t.Fatalf("Error creating team: %v", err)
+	}
+	if out.Team.ID == 0 {
+		t.Fatalf("Error reading team ID from output (out.Team.ID was empty)")
+	}
+	return out.Team.ID
+}
+
+// testAccTeamsCRUDRead runs the Read section of the CRUD test
+// (using GetDetailedTeam).
+func testAccTeamsCRUDRead(t *testing.T, id int, name string) {
+	c := New()
+	out, err := c.GetDetailedTeam(GetDetailedTeamInput{TeamID: id})
+	if err != nil {
+		t.Fatalf("Error reading team: %v", err)
+	}
+	if out.Team.Name != name {
+		t.Fatalf("Expected Name to be %s, got %v", name, out.Team.Name)
+	}
+}
+
+// testAccTeamsCRUDUpdate runs the Update section of the CRUD test
+// (using ModifyTeam).
+func testAccTeamsCRUDUpdate(t *testing.T, id int, in ModifyTeamInput) {
+	c := New()
+	in.Name = "Operations (updated)"
+	in.TeamID = id
+	_, err := c.ModifyTeam(in)
+	if err != nil {
+		t.Fatalf("Error updating team: %v", err)
+	}
+
+	testAccTeamsCRUDRead(t, id, "Operations (updated)")
+}
+
+// testAccTeamsCRUDDelete runs the Delete section of the CRUD test
+// (using DeleteTeam).
+func testAccTeamsCRUDDelete(t *testing.T, id int) {
+	c := New()
+	out, err := c.DeleteTeam(DeleteTeamInput{TeamID: id})
+	if err != nil {
+		t.Fatalf("Error deleting team: %v", err)
+	}
+	if strings.Contains(out.Message, "deleted") == false {
+		t.Fatalf("Expected out.Message to report deletion, got %v", out.Message)
+	}
+}
+
+// TestAccTeamsCRUD runs a full create-read-update-delete test for a Pingdom
+// team.
+func TestAccTeamsCRUD(t *testing.T) {
+	testacc.VetAccConditions(t)
+
+	create := CreateTeamInput{TeamConfiguration: TeamConfiguration{Name: "Operations"}}
+	update := ModifyTeamInput{TeamConfiguration: TeamConfiguration{Name: "Operations"}}
+
+	id := testAccTeamsCRUDCreate(t, create)
+	testAccTeamsCRUDRead(t, id, "Operations")
+	testAccTeamsCRUDUpdate(t, id, update)
+	testAccTeamsCRUDDelete(t, id)
+}
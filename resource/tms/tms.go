@@ -0,0 +1,608 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tms contains the methods necessary for managing transaction (TMS)
+// checks at Pingdom.
+//
+// Transaction checks are only available in version 3.1 of the Pingdom API,
+// which takes JSON request bodies rather than form-encoded ones. Version 3.1
+// also authenticates with an API token rather than an application key and
+// password, so this package only works once a token is configured, through
+// the APIToken field of pingdom.Config or the PINGDOM_API_TOKEN environment
+// variable. All functions return an error when no token is configured.
+package tms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// TransactionCheck is the base client for transaction check-related methods.
+type TransactionCheck struct {
+	client.Client
+}
+
+// New returns a new instance of the TransactionCheck API.
+func New(configs ...pingdom.Config) *TransactionCheck {
+	c := &TransactionCheck{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenRequired is returned by all transaction check functions when no
+// API token is configured, as version 3.1 of the API does not accept an
+// email address, password, and application key.
+var errTokenRequired = errors.New("Transaction checks require an API token")
+
+// sendRequest sends a JSON request through the client, if an API token is
+// configured.
+func (c *TransactionCheck) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken == "" {
+		return errTokenRequired
+	}
+	return c.SendJSONRequest(method, uri, in, out)
+}
+
+// StepArgs holds the arguments of a single transaction check step. Which
+// fields are used depends on the step function - for example, go_to uses
+// URL, and fill uses Input and Value.
+type StepArgs struct {
+	_ struct{}
+
+	// The value of a checkbox, for the check and uncheck functions.
+	Checkbox string `json:"checkbox,omitempty"`
+
+	// The CSS selector of the element to act on, for functions like click
+	// and wait_for_element.
+	Element string `json:"element,omitempty"`
+
+	// The CSS selector of the form, for the submit function.
+	Form string `json:"form,omitempty"`
+
+	// The CSS selector of the input field, for the fill function.
+	Input string `json:"input,omitempty"`
+
+	// The option to select, for the select function.
+	Option string `json:"option,omitempty"`
+
+	// The password, for the basic_auth function.
+	Password string `json:"password,omitempty"`
+
+	// The value of a radio button, for the select_radio function.
+	Radio string `json:"radio,omitempty"`
+
+	// The number of seconds to wait, for functions that take a timeout.
+	Seconds string `json:"seconds,omitempty"`
+
+	// The CSS selector of a select element, for the select function.
+	Select string `json:"select,omitempty"`
+
+	// The URL to navigate to, for the go_to and url functions.
+	URL string `json:"url,omitempty"`
+
+	// The user name, for the basic_auth function.
+	Username string `json:"username,omitempty"`
+
+	// The value to enter or compare against, for functions like fill and
+	// exists.
+	Value string `json:"value,omitempty"`
+}
+
+// Step is a single step in a transaction check script.
+type Step struct {
+	_ struct{}
+
+	// The step function. Some examples are go_to, click, fill, submit,
+	// wait_for_element, and exists.
+	Fn string `json:"fn"`
+
+	// The arguments to the step function.
+	Args StepArgs `json:"args"`
+}
+
+// Metadata holds the browser settings a transaction check is run with.
+type Metadata struct {
+	_ struct{}
+
+	// The browser viewport width, in pixels.
+	Width int `json:"width,omitempty"`
+
+	// The browser viewport height, in pixels.
+	Height int `json:"height,omitempty"`
+
+	// true to disable the same-origin policy in the browser.
+	DisableWebSecurity bool `json:"disableWebSecurity,omitempty"`
+}
+
+// TransactionCheckListEntry holds a single check from
+// GetTransactionCheckListOutput.
+type TransactionCheckListEntry struct {
+	_ struct{}
+
+	// The check identifier.
+	ID int
+
+	// The check name.
+	Name string
+
+	// true if the check is active.
+	Active bool
+
+	// The check type. One of script or recording.
+	Type string
+
+	// The check interval, in minutes.
+	Interval int
+
+	// The region the check is run from.
+	Region string
+
+	// The current status of the check.
+	Status string
+
+	// The creation time of the check (UNIX timestamp).
+	CreatedAt int `json:"created_at"`
+
+	// The time the check was last modified (UNIX timestamp).
+	ModifiedAt int `json:"modified_at"`
+
+	// The start time of the last downtime (UNIX timestamp).
+	LastDowntimeStart int `json:"last_downtime_start"`
+
+	// The end time of the last downtime (UNIX timestamp).
+	LastDowntimeEnd int `json:"last_downtime_end"`
+
+	// The tags for the check.
+	Tags []string
+}
+
+// GetTransactionCheckListInput contains the input to send to the
+// GetTransactionCheckList function.
+type GetTransactionCheckListInput struct {
+	_ struct{}
+
+	// Limits the number of returned checks to the specified quantity. The
+	// maximum is 1000.
+	Limit int `url:"limit,omitempty"`
+
+	// The offset for the listing (requires limit).
+	Offset int `url:"offset,omitempty"`
+
+	// Only return checks of this type. One of script or recording.
+	Type string `url:"type,omitempty"`
+
+	// Only return checks tagged with at least one of these tags.
+	Tags []string `url:"tags,comma,omitempty"`
+}
+
+// GetTransactionCheckListOutput contains the output for the
+// GetTransactionCheckList function.
+type GetTransactionCheckListOutput struct {
+	_ struct{}
+
+	// The list of checks.
+	Checks []TransactionCheckListEntry
+
+	// The limit that was applied to the listing.
+	Limit int
+
+	// The offset that was applied to the listing.
+	Offset int
+}
+
+// GetTransactionCheckList gets a list of all transaction checks.
+func (c *TransactionCheck) GetTransactionCheckList(in GetTransactionCheckListInput) (out GetTransactionCheckListOutput, err error) {
+	err = c.sendRequest("GET", "/api/3.1/tms/check", &in, &out)
+	return
+}
+
+// DetailedTransactionCheckEntry holds the check data returned by
+// GetDetailedTransactionCheck, CreateTransactionCheck, and
+// ModifyTransactionCheck.
+type DetailedTransactionCheckEntry struct {
+	_ struct{}
+
+	// The check identifier.
+	ID int
+
+	// The check name.
+	Name string
+
+	// true if the check is active.
+	Active bool
+
+	// The check interval, in minutes.
+	Interval int
+
+	// The region the check is run from.
+	Region string
+
+	// The current status of the check.
+	Status string
+
+	// The steps of the check script.
+	Steps []Step
+
+	// The browser settings the check is run with.
+	Metadata Metadata
+
+	// The contact IDs that receive alerts for the check.
+	ContactIDs []int `json:"contact_ids"`
+
+	// The team IDs that receive alerts for the check.
+	TeamIDs []int `json:"team_ids"`
+
+	// The integration IDs that receive alerts for the check.
+	IntegrationIDs []int `json:"integration_ids"`
+
+	// The custom message included in alerts.
+	CustomMessage string `json:"custom_message"`
+
+	// The number of consecutive failed runs before an alert is sent.
+	SendNotificationWhenDown int `json:"send_notification_when_down"`
+
+	// The severity level of alerts. One of high or low.
+	SeverityLevel string `json:"severity_level"`
+
+	// The creation time of the check (UNIX timestamp).
+	CreatedAt int `json:"created_at"`
+
+	// The time the check was last modified (UNIX timestamp).
+	ModifiedAt int `json:"modified_at"`
+
+	// The start time of the last downtime (UNIX timestamp).
+	LastDowntimeStart int `json:"last_downtime_start"`
+
+	// The end time of the last downtime (UNIX timestamp).
+	LastDowntimeEnd int `json:"last_downtime_end"`
+
+	// The tags for the check.
+	Tags []string
+}
+
+// GetDetailedTransactionCheckInput contains the input to send to the
+// GetDetailedTransactionCheck function.
+type GetDetailedTransactionCheckInput struct {
+	_ struct{}
+
+	// The ID of the check to get details for.
+	CheckID int
+}
+
+// GetDetailedTransactionCheckOutput contains the output for the
+// GetDetailedTransactionCheck function. Version 3.1 of the API returns the
+// check at the top level of the response.
+type GetDetailedTransactionCheckOutput struct {
+	_ struct{}
+
+	DetailedTransactionCheckEntry
+}
+
+// GetDetailedTransactionCheck gets detailed information about a single
+// transaction check, including its steps.
+func (c *TransactionCheck) GetDetailedTransactionCheck(in GetDetailedTransactionCheckInput) (out GetDetailedTransactionCheckOutput, err error) {
+	err = c.sendRequest("GET", fmt.Sprintf("/api/3.1/tms/check/%d", in.CheckID), nil, &out)
+	return
+}
+
+// TransactionCheckConfiguration is the structure for the
+// CreateTransactionCheck and ModifyTransactionCheck functions.
+type TransactionCheckConfiguration struct {
+	_ struct{}
+
+	// The check name.
+	Name string `json:"name,omitempty"`
+
+	// The steps of the check script.
+	Steps []Step `json:"steps,omitempty"`
+
+	// Set to false to create or modify the check as paused. Left unset, the
+	// check is active on creation and unchanged on modification.
+	Active *bool `json:"active,omitempty"`
+
+	// The check interval, in minutes. One of 5, 10, 20, 60, 720, or 1440.
+	Interval int `json:"interval,omitempty"`
+
+	// The region to run the check from. One of us-east, us-west, eu, or au.
+	Region string `json:"region,omitempty"`
+
+	// The browser settings to run the check with.
+	Metadata *Metadata `json:"metadata,omitempty"`
+
+	// The contact IDs that receive alerts for the check.
+	ContactIDs []int `json:"contact_ids,omitempty"`
+
+	// The team IDs that receive alerts for the check.
+	TeamIDs []int `json:"team_ids,omitempty"`
+
+	// The integration IDs that receive alerts for the check.
+	IntegrationIDs []int `json:"integration_ids,omitempty"`
+
+	// A custom message included in alerts.
+	CustomMessage string `json:"custom_message,omitempty"`
+
+	// The number of consecutive failed runs before an alert is sent.
+	SendNotificationWhenDown int `json:"send_notification_when_down,omitempty"`
+
+	// The severity level of alerts. One of high or low.
+	SeverityLevel string `json:"severity_level,omitempty"`
+
+	// The tags for the check.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// CreateTransactionCheckInput contains the input for the
+// CreateTransactionCheck function. Name and Steps are required.
+type CreateTransactionCheckInput struct {
+	_ struct{}
+
+	TransactionCheckConfiguration
+}
+
+// CreateTransactionCheckOutput contains the output for the
+// CreateTransactionCheck function.
+type CreateTransactionCheckOutput struct {
+	_ struct{}
+
+	DetailedTransactionCheckEntry
+}
+
+// CreateTransactionCheck creates a transaction check.
+func (c *TransactionCheck) CreateTransactionCheck(in CreateTransactionCheckInput) (out CreateTransactionCheckOutput, err error) {
+	err = c.sendRequest("POST", "/api/3.1/tms/check", &in, &out)
+	return
+}
+
+// ModifyTransactionCheckInput contains the input for the
+// ModifyTransactionCheck function.
+type ModifyTransactionCheckInput struct {
+	_ struct{}
+
+	// The ID of the check to modify.
+	CheckID int `json:"-"`
+
+	// The replacement check configuration. Note that Steps, if set, replaces
+	// the entire script.
+	TransactionCheckConfiguration
+}
+
+// ModifyTransactionCheckOutput contains the output for the
+// ModifyTransactionCheck function.
+type ModifyTransactionCheckOutput struct {
+	_ struct{}
+
+	DetailedTransactionCheckEntry
+}
+
+// ModifyTransactionCheck modifies an existing transaction check.
+func (c *TransactionCheck) ModifyTransactionCheck(in ModifyTransactionCheckInput) (out ModifyTransactionCheckOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/3.1/tms/check/%d", in.CheckID), &in, &out)
+	return
+}
+
+// DeleteTransactionCheckInput contains the input for the
+// DeleteTransactionCheck function.
+type DeleteTransactionCheckInput struct {
+	_ struct{}
+
+	// The ID of the check that you want to delete.
+	CheckID int
+}
+
+// DeleteTransactionCheckOutput contains the output for the
+// DeleteTransactionCheck function.
+type DeleteTransactionCheckOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteTransactionCheck deletes an existing transaction check from Pingdom.
+func (c *TransactionCheck) DeleteTransactionCheck(in DeleteTransactionCheckInput) (out DeleteTransactionCheckOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/3.1/tms/check/%d", in.CheckID), nil, &out)
+	return
+}
+
+// TransactionCheckStateEntry holds a single state change from
+// TransactionCheckStatusReportEntry.
+type TransactionCheckStateEntry struct {
+	_ struct{}
+
+	// The status of the check during this period. One of successful,
+	// failing, or unknown.
+	Status string
+
+	// The start of the period (RFC 3339 timestamp).
+	From string
+
+	// The end of the period (RFC 3339 timestamp).
+	To string
+
+	// The step, or other part of the check, that failed.
+	ErrorIn string `json:"error_in"`
+
+	// A description of the failure.
+	Message string
+}
+
+// TransactionCheckStatusReportEntry is the actual report data in the output
+// of GetTransactionCheckStatusReport.
+type TransactionCheckStatusReportEntry struct {
+	_ struct{}
+
+	// The ID of the check the report is for.
+	CheckID int `json:"check_id"`
+
+	// The name of the check the report is for.
+	Name string
+
+	// The state changes of the check over the reported period.
+	States []TransactionCheckStateEntry
+}
+
+// DownPeriods returns the states in the report during which the check was
+// failing.
+func (e TransactionCheckStatusReportEntry) DownPeriods() []TransactionCheckStateEntry {
+	var down []TransactionCheckStateEntry
+	for _, v := range e.States {
+		if v.Status == "failing" {
+			down = append(down, v)
+		}
+	}
+	return down
+}
+
+// GetTransactionCheckStatusReportInput contains the input to send to the
+// GetTransactionCheckStatusReport function.
+type GetTransactionCheckStatusReportInput struct {
+	_ struct{}
+
+	// The ID of the check to get the report for.
+	CheckID int `url:"-"`
+
+	// The start of the reporting period (UNIX timestamp). Defaults to one
+	// week ago.
+	From int `url:"from,omitempty"`
+
+	// The end of the reporting period (UNIX timestamp). Defaults to now.
+	To int `url:"to,omitempty"`
+
+	// The sort order of the states. One of asc or desc.
+	Order string `url:"order,omitempty"`
+
+	// Limits the number of returned states to the specified quantity.
+	Limit int `url:"limit,omitempty"`
+
+	// The offset for the listing (requires limit).
+	Offset int `url:"offset,omitempty"`
+}
+
+// GetTransactionCheckStatusReportOutput contains the output for the
+// GetTransactionCheckStatusReport function.
+type GetTransactionCheckStatusReportOutput struct {
+	_ struct{}
+
+	// The report data.
+	Report TransactionCheckStatusReportEntry
+}
+
+// GetTransactionCheckStatusReport gets the status history of a transaction
+// check.
+func (c *TransactionCheck) GetTransactionCheckStatusReport(in GetTransactionCheckStatusReportInput) (out GetTransactionCheckStatusReportOutput, err error) {
+	err = c.sendRequest("GET", fmt.Sprintf("/api/3.1/tms/check/%d/report/status", in.CheckID), &in, &out)
+	return
+}
+
+// TransactionCheckStepPerformanceEntry holds the timing of a single step
+// from TransactionCheckIntervalEntry.
+type TransactionCheckStepPerformanceEntry struct {
+	_ struct{}
+
+	// The step the timing is for.
+	Step Step
+
+	// The average response time of the step, in milliseconds.
+	AverageResponse int `json:"average_response"`
+}
+
+// TransactionCheckIntervalEntry holds a single interval from
+// TransactionCheckPerformanceReportEntry.
+type TransactionCheckIntervalEntry struct {
+	_ struct{}
+
+	// The start of the interval (RFC 3339 timestamp).
+	From string
+
+	// The average response time of the whole check, in milliseconds.
+	AverageResponse int `json:"average_response"`
+
+	// The number of seconds the check was down during the interval. Only
+	// returned if IncludeUptime was set.
+	Downtime int
+
+	// The number of seconds the check was up during the interval. Only
+	// returned if IncludeUptime was set.
+	Uptime int
+
+	// The number of seconds the check was not monitored during the
+	// interval. Only returned if IncludeUptime was set.
+	Unmonitored int
+
+	// The per-step timings for the interval.
+	Steps []TransactionCheckStepPerformanceEntry
+}
+
+// TransactionCheckPerformanceReportEntry is the actual report data in the
+// output of GetTransactionCheckPerformanceReport.
+type TransactionCheckPerformanceReportEntry struct {
+	_ struct{}
+
+	// The ID of the check the report is for.
+	CheckID int `json:"check_id"`
+
+	// The name of the check the report is for.
+	Name string
+
+	// The resolution of the intervals. One of hour, day, or week.
+	Resolution string
+
+	// The intervals of the report.
+	Intervals []TransactionCheckIntervalEntry
+}
+
+// GetTransactionCheckPerformanceReportInput contains the input to send to
+// the GetTransactionCheckPerformanceReport function.
+type GetTransactionCheckPerformanceReportInput struct {
+	_ struct{}
+
+	// The ID of the check to get the report for.
+	CheckID int `url:"-"`
+
+	// The start of the reporting period (UNIX timestamp).
+	From int `url:"from,omitempty"`
+
+	// The end of the reporting period (UNIX timestamp).
+	To int `url:"to,omitempty"`
+
+	// The interval resolution. One of hour, day, or week.
+	Resolution string `url:"resolution,omitempty"`
+
+	// Include uptime and downtime for each interval.
+	IncludeUptime bool `url:"include_uptime,omitempty"`
+
+	// The sort order of the intervals. One of asc or desc.
+	Order string `url:"order,omitempty"`
+}
+
+// GetTransactionCheckPerformanceReportOutput contains the output for the
+// GetTransactionCheckPerformanceReport function.
+type GetTransactionCheckPerformanceReportOutput struct {
+	_ struct{}
+
+	// The report data.
+	Report TransactionCheckPerformanceReportEntry
+}
+
+// GetTransactionCheckPerformanceReport gets the average response times of a
+// transaction check, and each of its steps, at hour, day, or week
+// resolution.
+func (c *TransactionCheck) GetTransactionCheckPerformanceReport(in GetTransactionCheckPerformanceReportInput) (out GetTransactionCheckPerformanceReportOutput, err error) {
+	err = c.sendRequest("GET", fmt.Sprintf("/api/3.1/tms/check/%d/report/performance", in.CheckID), &in, &out)
+	return
+}
@@ -0,0 +1,859 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tms
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+		APIToken:     "abcdefgh0123456789token",
+	}
+}
+
+// httpTokenTestServer serves a single version 3.1 transaction check request,
+// and fails it if the method, path, token, or JSON body differ from the ones
+// given.
+func httpTokenTestServer(method, path, body, resp string) *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if r.Method != method || r.URL.Path != path || string(b) != body || r.Header.Get("Authorization") != "Bearer abcdefgh0123456789token" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		if method != "GET" && r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, errorResponseText, http.StatusForbidden)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, resp, http.StatusOK)
+	})
+}
+
+func stepsData() []Step {
+	return []Step{
+		Step{
+			Fn:   "go_to",
+			Args: StepArgs{URL: "https://www.example.com/login"},
+		},
+		Step{
+			Fn:   "fill",
+			Args: StepArgs{Input: "#username", Value: "jdoe"},
+		},
+		Step{
+			Fn:   "submit",
+			Args: StepArgs{Form: "#login"},
+		},
+		Step{
+			Fn:   "wait_for_element",
+			Args: StepArgs{Element: ".welcome"},
+		},
+	}
+}
+
+const stepsText = `[{"fn":"go_to","args":{"url":"https://www.example.com/login"}},` +
+	`{"fn":"fill","args":{"input":"#username","value":"jdoe"}},` +
+	`{"fn":"submit","args":{"form":"#login"}},` +
+	`{"fn":"wait_for_element","args":{"element":".welcome"}}]`
+
+func detailedTransactionCheckEntryData() DetailedTransactionCheckEntry {
+	return DetailedTransactionCheckEntry{
+		ID:                       1,
+		Name:                     "Login",
+		Active:                   true,
+		Interval:                 10,
+		Region:                   "eu",
+		Status:                   "successful",
+		Steps:                    stepsData(),
+		Metadata:                 Metadata{Width: 1950, Height: 1080},
+		ContactIDs:               []int{111250},
+		TeamIDs:                  []int{12},
+		IntegrationIDs:           []int{},
+		CustomMessage:            "Login is broken",
+		SendNotificationWhenDown: 2,
+		SeverityLevel:            "high",
+		CreatedAt:                1553070682,
+		ModifiedAt:               1553070968,
+		LastDowntimeStart:        1553080682,
+		LastDowntimeEnd:          1553081268,
+		Tags:                     []string{"login"},
+	}
+}
+
+const detailedTransactionCheckEntryText = `
+{
+	"id": 1,
+	"name": "Login",
+	"active": true,
+	"interval": 10,
+	"region": "eu",
+	"status": "successful",
+	"steps": ` + stepsText + `,
+	"metadata": {
+		"width": 1950,
+		"height": 1080,
+		"disableWebSecurity": false
+	},
+	"contact_ids": [111250],
+	"team_ids": [12],
+	"integration_ids": [],
+	"custom_message": "Login is broken",
+	"send_notification_when_down": 2,
+	"severity_level": "high",
+	"created_at": 1553070682,
+	"modified_at": 1553070968,
+	"last_downtime_start": 1553080682,
+	"last_downtime_end": 1553081268,
+	"tags": ["login"]
+}
+`
+
+func getTransactionCheckListInputData() GetTransactionCheckListInput {
+	return GetTransactionCheckListInput{
+		Limit:  10,
+		Offset: 0,
+		Type:   "script",
+		Tags:   []string{"login", "checkout"},
+	}
+}
+
+const getTransactionCheckListInputText = "limit=10&tags=login%2Ccheckout&type=script"
+
+func getTransactionCheckListOutputData() GetTransactionCheckListOutput {
+	return GetTransactionCheckListOutput{
+		Checks: []TransactionCheckListEntry{
+			TransactionCheckListEntry{
+				ID:                1,
+				Name:              "Login",
+				Active:            true,
+				Type:              "script",
+				Interval:          10,
+				Region:            "eu",
+				Status:            "successful",
+				CreatedAt:         1553070682,
+				ModifiedAt:        1553070968,
+				LastDowntimeStart: 1553080682,
+				LastDowntimeEnd:   1553081268,
+				Tags:              []string{"login"},
+			},
+		},
+		Limit:  10,
+		Offset: 0,
+	}
+}
+
+const getTransactionCheckListOutputText = `
+{
+	"checks": [{
+		"id": 1,
+		"name": "Login",
+		"active": true,
+		"type": "script",
+		"interval": 10,
+		"region": "eu",
+		"status": "successful",
+		"created_at": 1553070682,
+		"modified_at": 1553070968,
+		"last_downtime_start": 1553080682,
+		"last_downtime_end": 1553081268,
+		"tags": ["login"]
+	}],
+	"limit": 10,
+	"offset": 0
+}
+`
+
+func httpGetTransactionCheckListTestServer() *httptest.Server {
+	return httpTokenTestServer("GET", "/api/3.1/tms/check", "", getTransactionCheckListOutputText)
+}
+
+func getDetailedTransactionCheckInputData() GetDetailedTransactionCheckInput {
+	return GetDetailedTransactionCheckInput{
+		CheckID: 1,
+	}
+}
+
+func getDetailedTransactionCheckOutputData() GetDetailedTransactionCheckOutput {
+	return GetDetailedTransactionCheckOutput{
+		DetailedTransactionCheckEntry: detailedTransactionCheckEntryData(),
+	}
+}
+
+func httpGetDetailedTransactionCheckTestServer() *httptest.Server {
+	return httpTokenTestServer("GET", "/api/3.1/tms/check/1", "", detailedTransactionCheckEntryText)
+}
+
+func transactionCheckConfigurationData() TransactionCheckConfiguration {
+	active := false
+	return TransactionCheckConfiguration{
+		Name:                     "Login",
+		Steps:                    stepsData(),
+		Active:                   &active,
+		Interval:                 10,
+		Region:                   "eu",
+		ContactIDs:               []int{111250},
+		SendNotificationWhenDown: 2,
+		SeverityLevel:            "high",
+	}
+}
+
+const transactionCheckConfigurationText = `{"name":"Login","steps":` + stepsText + `,` +
+	`"active":false,"interval":10,"region":"eu","contact_ids":[111250],` +
+	`"send_notification_when_down":2,"severity_level":"high"}`
+
+func createTransactionCheckInputData() CreateTransactionCheckInput {
+	return CreateTransactionCheckInput{
+		TransactionCheckConfiguration: transactionCheckConfigurationData(),
+	}
+}
+
+func createTransactionCheckOutputData() CreateTransactionCheckOutput {
+	return CreateTransactionCheckOutput{
+		DetailedTransactionCheckEntry: detailedTransactionCheckEntryData(),
+	}
+}
+
+func httpCreateTransactionCheckTestServer() *httptest.Server {
+	return httpTokenTestServer("POST", "/api/3.1/tms/check", transactionCheckConfigurationText, detailedTransactionCheckEntryText)
+}
+
+func modifyTransactionCheckInputData() ModifyTransactionCheckInput {
+	return ModifyTransactionCheckInput{
+		CheckID:                       1,
+		TransactionCheckConfiguration: transactionCheckConfigurationData(),
+	}
+}
+
+func modifyTransactionCheckOutputData() ModifyTransactionCheckOutput {
+	return ModifyTransactionCheckOutput{
+		DetailedTransactionCheckEntry: detailedTransactionCheckEntryData(),
+	}
+}
+
+func httpModifyTransactionCheckTestServer() *httptest.Server {
+	return httpTokenTestServer("PUT", "/api/3.1/tms/check/1", transactionCheckConfigurationText, detailedTransactionCheckEntryText)
+}
+
+func deleteTransactionCheckInputData() DeleteTransactionCheckInput {
+	return DeleteTransactionCheckInput{
+		CheckID: 1,
+	}
+}
+
+func deleteTransactionCheckOutputData() DeleteTransactionCheckOutput {
+	return DeleteTransactionCheckOutput{
+		Message: "Deletion of check was successful!",
+	}
+}
+
+const deleteTransactionCheckOutputText = `
+{
+	"message": "Deletion of check was successful!"
+}
+`
+
+func httpDeleteTransactionCheckTestServer() *httptest.Server {
+	return httpTokenTestServer("DELETE", "/api/3.1/tms/check/1", "", deleteTransactionCheckOutputText)
+}
+
+func transactionCheckStatusReportEntryData() TransactionCheckStatusReportEntry {
+	return TransactionCheckStatusReportEntry{
+		CheckID: 1,
+		Name:    "Login",
+		States: []TransactionCheckStateEntry{
+			TransactionCheckStateEntry{
+				Status: "successful",
+				From:   "2019-05-12T00:00:00Z",
+				To:     "2019-05-12T10:20:00Z",
+			},
+			TransactionCheckStateEntry{
+				Status:  "failing",
+				From:    "2019-05-12T10:20:00Z",
+				To:      "2019-05-12T10:40:00Z",
+				ErrorIn: "wait_for_element",
+				Message: "Element .welcome not found",
+			},
+			TransactionCheckStateEntry{
+				Status: "successful",
+				From:   "2019-05-12T10:40:00Z",
+				To:     "2019-05-13T00:00:00Z",
+			},
+		},
+	}
+}
+
+func getTransactionCheckStatusReportInputData() GetTransactionCheckStatusReportInput {
+	return GetTransactionCheckStatusReportInput{
+		CheckID: 1,
+		From:    1557619200,
+		To:      1557705600,
+		Order:   "asc",
+	}
+}
+
+const getTransactionCheckStatusReportInputText = "from=1557619200&order=asc&to=1557705600"
+
+func getTransactionCheckStatusReportOutputData() GetTransactionCheckStatusReportOutput {
+	return GetTransactionCheckStatusReportOutput{
+		Report: transactionCheckStatusReportEntryData(),
+	}
+}
+
+const getTransactionCheckStatusReportOutputText = `
+{
+	"report": {
+		"check_id": 1,
+		"name": "Login",
+		"states": [{
+			"status": "successful",
+			"from": "2019-05-12T00:00:00Z",
+			"to": "2019-05-12T10:20:00Z"
+		}, {
+			"status": "failing",
+			"from": "2019-05-12T10:20:00Z",
+			"to": "2019-05-12T10:40:00Z",
+			"error_in": "wait_for_element",
+			"message": "Element .welcome not found"
+		}, {
+			"status": "successful",
+			"from": "2019-05-12T10:40:00Z",
+			"to": "2019-05-13T00:00:00Z"
+		}]
+	}
+}
+`
+
+func httpGetTransactionCheckStatusReportTestServer() *httptest.Server {
+	return httpTokenTestServer("GET", "/api/3.1/tms/check/1/report/status", "", getTransactionCheckStatusReportOutputText)
+}
+
+func getTransactionCheckPerformanceReportInputData() GetTransactionCheckPerformanceReportInput {
+	return GetTransactionCheckPerformanceReportInput{
+		CheckID:       1,
+		From:          1557619200,
+		To:            1557705600,
+		Resolution:    "hour",
+		IncludeUptime: true,
+	}
+}
+
+const getTransactionCheckPerformanceReportInputText = "from=1557619200&include_uptime=true&resolution=hour&to=1557705600"
+
+func getTransactionCheckPerformanceReportOutputData() GetTransactionCheckPerformanceReportOutput {
+	return GetTransactionCheckPerformanceReportOutput{
+		Report: TransactionCheckPerformanceReportEntry{
+			CheckID:    1,
+			Name:       "Login",
+			Resolution: "hour",
+			Intervals: []TransactionCheckIntervalEntry{
+				TransactionCheckIntervalEntry{
+					From:            "2019-05-12T10:00:00Z",
+					AverageResponse: 2811,
+					Downtime:        1200,
+					Uptime:          2400,
+					Steps: []TransactionCheckStepPerformanceEntry{
+						TransactionCheckStepPerformanceEntry{
+							Step: Step{
+								Fn:   "go_to",
+								Args: StepArgs{URL: "https://www.example.com/login"},
+							},
+							AverageResponse: 1523,
+						},
+						TransactionCheckStepPerformanceEntry{
+							Step: Step{
+								Fn:   "submit",
+								Args: StepArgs{Form: "#login"},
+							},
+							AverageResponse: 1288,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const getTransactionCheckPerformanceReportOutputText = `
+{
+	"report": {
+		"check_id": 1,
+		"name": "Login",
+		"resolution": "hour",
+		"intervals": [{
+			"from": "2019-05-12T10:00:00Z",
+			"average_response": 2811,
+			"downtime": 1200,
+			"uptime": 2400,
+			"unmonitored": 0,
+			"steps": [{
+				"step": {
+					"fn": "go_to",
+					"args": {"url": "https://www.example.com/login"}
+				},
+				"average_response": 1523
+			}, {
+				"step": {
+					"fn": "submit",
+					"args": {"form": "#login"}
+				},
+				"average_response": 1288
+			}]
+		}]
+	}
+}
+`
+
+func httpGetTransactionCheckPerformanceReportTestServer() *httptest.Server {
+	return httpTokenTestServer("GET", "/api/3.1/tms/check/1/report/performance", "", getTransactionCheckPerformanceReportOutputText)
+}
+
+func TestTransactionCheckNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestTransactionCheckNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestTransactionCheckNoTokenError(t *testing.T) {
+	ts := httpGetTransactionCheckListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	cfg.APIToken = ""
+	c := New(cfg)
+
+	if _, err := c.GetTransactionCheckList(getTransactionCheckListInputData()); err != errTokenRequired {
+		t.Fatalf("expected GetTransactionCheckList to fail with %v, got %v", errTokenRequired, err)
+	}
+	if _, err := c.CreateTransactionCheck(createTransactionCheckInputData()); err != errTokenRequired {
+		t.Fatalf("expected CreateTransactionCheck to fail with %v, got %v", errTokenRequired, err)
+	}
+	if _, err := c.DeleteTransactionCheck(deleteTransactionCheckInputData()); err != errTokenRequired {
+		t.Fatalf("expected DeleteTransactionCheck to fail with %v, got %v", errTokenRequired, err)
+	}
+}
+
+func TestGetTransactionCheckListQueryText(t *testing.T) {
+	in := getTransactionCheckListInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getTransactionCheckListInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetTransactionCheckList(t *testing.T) {
+	ts := httpGetTransactionCheckListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckListInputData()
+	out, err := c.GetTransactionCheckList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getTransactionCheckListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetTransactionCheckListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckListInputData()
+	_, err := c.GetTransactionCheckList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetDetailedTransactionCheck(t *testing.T) {
+	ts := httpGetDetailedTransactionCheckTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedTransactionCheckInputData()
+	out, err := c.GetDetailedTransactionCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getDetailedTransactionCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetDetailedTransactionCheckError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getDetailedTransactionCheckInputData()
+	_, err := c.GetDetailedTransactionCheck(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestTransactionCheckConfigurationJSONText(t *testing.T) {
+	in := createTransactionCheckInputData()
+	b, _ := json.Marshal(in)
+	out := string(b)
+	expected := transactionCheckConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyTransactionCheckJSONText(t *testing.T) {
+	in := modifyTransactionCheckInputData()
+	b, _ := json.Marshal(in)
+	out := string(b)
+	expected := transactionCheckConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateTransactionCheck(t *testing.T) {
+	ts := httpCreateTransactionCheckTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createTransactionCheckInputData()
+	out, err := c.CreateTransactionCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createTransactionCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateTransactionCheckError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createTransactionCheckInputData()
+	_, err := c.CreateTransactionCheck(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyTransactionCheck(t *testing.T) {
+	ts := httpModifyTransactionCheckTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyTransactionCheckInputData()
+	out, err := c.ModifyTransactionCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyTransactionCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyTransactionCheckError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyTransactionCheckInputData()
+	_, err := c.ModifyTransactionCheck(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteTransactionCheck(t *testing.T) {
+	ts := httpDeleteTransactionCheckTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteTransactionCheckInputData()
+	out, err := c.DeleteTransactionCheck(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteTransactionCheckOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteTransactionCheckError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteTransactionCheckInputData()
+	_, err := c.DeleteTransactionCheck(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestGetTransactionCheckStatusReportQueryText(t *testing.T) {
+	in := getTransactionCheckStatusReportInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getTransactionCheckStatusReportInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetTransactionCheckStatusReport(t *testing.T) {
+	ts := httpGetTransactionCheckStatusReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckStatusReportInputData()
+	out, err := c.GetTransactionCheckStatusReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getTransactionCheckStatusReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetTransactionCheckStatusReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckStatusReportInputData()
+	_, err := c.GetTransactionCheckStatusReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestTransactionCheckStatusReportDownPeriods(t *testing.T) {
+	in := transactionCheckStatusReportEntryData()
+	out := in.DownPeriods()
+	expected := []TransactionCheckStateEntry{in.States[1]}
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetTransactionCheckPerformanceReportQueryText(t *testing.T) {
+	in := getTransactionCheckPerformanceReportInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := getTransactionCheckPerformanceReportInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestGetTransactionCheckPerformanceReport(t *testing.T) {
+	ts := httpGetTransactionCheckPerformanceReportTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckPerformanceReportInputData()
+	out, err := c.GetTransactionCheckPerformanceReport(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getTransactionCheckPerformanceReportOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetTransactionCheckPerformanceReportError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getTransactionCheckPerformanceReportInputData()
+	_, err := c.GetTransactionCheckPerformanceReport(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package traceroute contains the methods necessary for running traceroutes
+// from Pingdom probe servers.
+package traceroute
+
+import (
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// Traceroute is the base client for traceroute-related methods.
+type Traceroute struct {
+	client.Client
+}
+
+// New returns a new instance of the Traceroute API.
+func New(configs ...pingdom.Config) *Traceroute {
+	c := &Traceroute{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// TracerouteEntry is the actual traceroute data in the output of
+// RunTraceroute.
+type TracerouteEntry struct {
+	_ struct{}
+
+	// The raw traceroute output.
+	Result string
+
+	// The ID of the probe that ran the traceroute.
+	ProbeID int
+
+	// The description of the probe that ran the traceroute.
+	ProbeDescription string
+}
+
+// RunTracerouteInput contains the input to send to the RunTraceroute
+// function.
+type RunTracerouteInput struct {
+	_ struct{}
+
+	// The target hostname or IP address.
+	Host string `url:"host"`
+
+	// The ID of the probe to run the traceroute from. Defaults to a random
+	// probe.
+	ProbeID int `url:"probeid,omitempty"`
+}
+
+// RunTracerouteOutput contains the output for the RunTraceroute function.
+type RunTracerouteOutput struct {
+	_ struct{}
+
+	// The traceroute data.
+	Traceroute TracerouteEntry
+}
+
+// RunTraceroute performs a traceroute to a host from a Pingdom probe
+// server. The traceroute is run synchronously, so this function returns once
+// the traceroute has completed.
+func (c *Traceroute) RunTraceroute(in RunTracerouteInput) (out RunTracerouteOutput, err error) {
+	err = c.SendRequest("GET", "/api/2.0/traceroute", &in, &out)
+	return
+}
@@ -0,0 +1,417 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package users contains the methods necessary for managing users and their
+// notification targets at Pingdom.
+//
+// Users replace the flat notification contacts of the contacts package in
+// version 2.1 of the API. Each user can have several email and SMS targets,
+// each with its own severity level.
+//
+// Version 2.1 of the API does not accept API tokens, so all functions return
+// an error when a token is configured.
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom/client"
+)
+
+// User is the base client for user-related methods.
+type User struct {
+	client.Client
+}
+
+// New returns a new instance of the User API.
+func New(configs ...pingdom.Config) *User {
+	c := &User{
+		Client: *client.New(configs...),
+	}
+	return c
+}
+
+// errTokenNotSupported is returned by all user functions when using an API
+// token, as users are only available in version 2.1 of the API, which does
+// not accept tokens.
+var errTokenNotSupported = errors.New("Users cannot be managed with an API token, use an email address, password, and application key instead")
+
+// sendRequest sends a request through the client, unless an API token is
+// configured.
+func (c *User) sendRequest(method, uri string, in, out interface{}) error {
+	if c.Config.APIToken != "" {
+		return errTokenNotSupported
+	}
+	return c.SendRequest(method, uri, in, out)
+}
+
+// UserSMSEntry holds a single SMS target of a user.
+type UserSMSEntry struct {
+	_ struct{}
+
+	// The SMS target identifier.
+	ID int
+
+	// The severity level that the target receives alerts for. One of HIGH
+	// or LOW.
+	Severity string
+
+	// The cell phone country code.
+	CountryCode string `json:"country_code"`
+
+	// The cell phone number, without the country code.
+	Number string
+
+	// The SMS provider.
+	Provider string
+}
+
+// UserEmailEntry holds a single email target of a user.
+type UserEmailEntry struct {
+	_ struct{}
+
+	// The email target identifier.
+	ID int
+
+	// The severity level that the target receives alerts for. One of HIGH
+	// or LOW.
+	Severity string
+
+	// The email address.
+	Address string
+}
+
+// UserListEntry holds a single user from GetUserListOutput.
+type UserListEntry struct {
+	_ struct{}
+
+	// The user identifier.
+	ID int
+
+	// The user name.
+	Name string
+
+	// YES if alerts to the user are paused, otherwise NO.
+	Paused string
+
+	// The SMS targets of the user.
+	SMS []UserSMSEntry
+
+	// The email targets of the user.
+	Email []UserEmailEntry
+}
+
+// GetUserListInput contains the input to send to the GetUserList function.
+// The user list endpoint takes no parameters.
+type GetUserListInput struct {
+	_ struct{}
+}
+
+// GetUserListOutput contains the output for the GetUserList function.
+type GetUserListOutput struct {
+	_ struct{}
+
+	// The list of users.
+	Users []UserListEntry
+}
+
+// GetUserList gets a list of all users and their notification targets.
+func (c *User) GetUserList(in GetUserListInput) (out GetUserListOutput, err error) {
+	err = c.sendRequest("GET", "/api/2.1/users", &in, &out)
+	return
+}
+
+// CreateUserInput contains the input for the CreateUser function.
+type CreateUserInput struct {
+	_ struct{}
+
+	// The user name.
+	Name string `url:"name"`
+}
+
+// CreateUserEntry is the actual user data in the output of CreateUser.
+type CreateUserEntry struct {
+	_ struct{}
+
+	// The ID of the user that was created.
+	ID int
+}
+
+// CreateUserOutput contains the output for the CreateUser function.
+type CreateUserOutput struct {
+	_ struct{}
+
+	// The user data.
+	User CreateUserEntry
+}
+
+// CreateUser creates a user. Notification targets are added separately,
+// with CreateUserEmail and CreateUserSMS.
+func (c *User) CreateUser(in CreateUserInput) (out CreateUserOutput, err error) {
+	err = c.sendRequest("POST", "/api/2.1/users", &in, &out)
+	return
+}
+
+// ModifyUserInput contains the input for the ModifyUser function.
+type ModifyUserInput struct {
+	_ struct{}
+
+	// The ID of the user to modify.
+	UserID int `url:"-"`
+
+	// The user name.
+	Name string `url:"name,omitempty"`
+
+	// YES to make this the primary user of the account, otherwise NO.
+	Primary string `url:"primary,omitempty"`
+
+	// YES to pause alerts to the user, NO to resume them.
+	Paused string `url:"paused,omitempty"`
+}
+
+// ModifyUserOutput contains the output for the ModifyUser function.
+type ModifyUserOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyUser modifies an existing user.
+func (c *User) ModifyUser(in ModifyUserInput) (out ModifyUserOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.1/users/%d", in.UserID), &in, &out)
+	return
+}
+
+// DeleteUserInput contains the input for the DeleteUser function.
+type DeleteUserInput struct {
+	_ struct{}
+
+	// The ID of the user that you want to delete.
+	UserID int
+}
+
+// DeleteUserOutput contains the output for the DeleteUser function.
+type DeleteUserOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteUser deletes an existing user, and all of its notification targets,
+// from Pingdom.
+func (c *User) DeleteUser(in DeleteUserInput) (out DeleteUserOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.1/users/%d", in.UserID), nil, &out)
+	return
+}
+
+// ContactTargetEntry is the actual target data in the output of
+// CreateUserEmail and CreateUserSMS.
+type ContactTargetEntry struct {
+	_ struct{}
+
+	// The ID of the target that was created.
+	ID int
+}
+
+// UserEmailConfiguration is the structure for the CreateUserEmail and
+// ModifyUserEmail functions.
+type UserEmailConfiguration struct {
+	_ struct{}
+
+	// The email address.
+	Address string `url:"address,omitempty"`
+
+	// The severity level that the target receives alerts for. One of HIGH
+	// or LOW.
+	SeverityLevel string `url:"severitylevel,omitempty"`
+}
+
+// CreateUserEmailInput contains the input for the CreateUserEmail function.
+type CreateUserEmailInput struct {
+	_ struct{}
+
+	// The ID of the user to add the email target to.
+	UserID int `url:"-"`
+
+	UserEmailConfiguration
+}
+
+// CreateUserEmailOutput contains the output for the CreateUserEmail
+// function.
+type CreateUserEmailOutput struct {
+	_ struct{}
+
+	// The target data.
+	ContactTarget ContactTargetEntry `json:"contact_target"`
+}
+
+// CreateUserEmail adds an email target to an existing user.
+func (c *User) CreateUserEmail(in CreateUserEmailInput) (out CreateUserEmailOutput, err error) {
+	err = c.sendRequest("POST", fmt.Sprintf("/api/2.1/users/%d/email", in.UserID), &in, &out)
+	return
+}
+
+// ModifyUserEmailInput contains the input for the ModifyUserEmail function.
+type ModifyUserEmailInput struct {
+	_ struct{}
+
+	// The ID of the user the email target belongs to.
+	UserID int `url:"-"`
+
+	// The ID of the email target to modify.
+	TargetID int `url:"-"`
+
+	UserEmailConfiguration
+}
+
+// ModifyUserEmailOutput contains the output for the ModifyUserEmail
+// function.
+type ModifyUserEmailOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyUserEmail modifies an email target of an existing user.
+func (c *User) ModifyUserEmail(in ModifyUserEmailInput) (out ModifyUserEmailOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.1/users/%d/email/%d", in.UserID, in.TargetID), &in, &out)
+	return
+}
+
+// DeleteUserEmailInput contains the input for the DeleteUserEmail function.
+type DeleteUserEmailInput struct {
+	_ struct{}
+
+	// The ID of the user the email target belongs to.
+	UserID int
+
+	// The ID of the email target to delete.
+	TargetID int
+}
+
+// DeleteUserEmailOutput contains the output for the DeleteUserEmail
+// function.
+type DeleteUserEmailOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteUserEmail deletes an email target from an existing user.
+func (c *User) DeleteUserEmail(in DeleteUserEmailInput) (out DeleteUserEmailOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.1/users/%d/email/%d", in.UserID, in.TargetID), nil, &out)
+	return
+}
+
+// UserSMSConfiguration is the structure for the CreateUserSMS and
+// ModifyUserSMS functions.
+type UserSMSConfiguration struct {
+	_ struct{}
+
+	// The cell phone number, without the country code. In some countries,
+	// you will need to exclude leading zeroes.
+	Number string `url:"number,omitempty"`
+
+	// The cell phone country code.
+	CountryCode string `url:"countrycode,omitempty"`
+
+	// The SMS provider. One of nexmo, bulksms, esendex, or cellsynt.
+	Provider string `url:"provider,omitempty"`
+
+	// The severity level that the target receives alerts for. One of HIGH
+	// or LOW.
+	SeverityLevel string `url:"severitylevel,omitempty"`
+}
+
+// CreateUserSMSInput contains the input for the CreateUserSMS function.
+type CreateUserSMSInput struct {
+	_ struct{}
+
+	// The ID of the user to add the SMS target to.
+	UserID int `url:"-"`
+
+	UserSMSConfiguration
+}
+
+// CreateUserSMSOutput contains the output for the CreateUserSMS function.
+type CreateUserSMSOutput struct {
+	_ struct{}
+
+	// The target data.
+	ContactTarget ContactTargetEntry `json:"contact_target"`
+}
+
+// CreateUserSMS adds an SMS target to an existing user.
+func (c *User) CreateUserSMS(in CreateUserSMSInput) (out CreateUserSMSOutput, err error) {
+	err = c.sendRequest("POST", fmt.Sprintf("/api/2.1/users/%d/sms", in.UserID), &in, &out)
+	return
+}
+
+// ModifyUserSMSInput contains the input for the ModifyUserSMS function.
+type ModifyUserSMSInput struct {
+	_ struct{}
+
+	// The ID of the user the SMS target belongs to.
+	UserID int `url:"-"`
+
+	// The ID of the SMS target to modify.
+	TargetID int `url:"-"`
+
+	UserSMSConfiguration
+}
+
+// ModifyUserSMSOutput contains the output for the ModifyUserSMS function.
+type ModifyUserSMSOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// ModifyUserSMS modifies an SMS target of an existing user.
+func (c *User) ModifyUserSMS(in ModifyUserSMSInput) (out ModifyUserSMSOutput, err error) {
+	err = c.sendRequest("PUT", fmt.Sprintf("/api/2.1/users/%d/sms/%d", in.UserID, in.TargetID), &in, &out)
+	return
+}
+
+// DeleteUserSMSInput contains the input for the DeleteUserSMS function.
+type DeleteUserSMSInput struct {
+	_ struct{}
+
+	// The ID of the user the SMS target belongs to.
+	UserID int
+
+	// The ID of the SMS target to delete.
+	TargetID int
+}
+
+// DeleteUserSMSOutput contains the output for the DeleteUserSMS function.
+type DeleteUserSMSOutput struct {
+	_ struct{}
+
+	// The success message.
+	Message string
+}
+
+// DeleteUserSMS deletes an SMS target from an existing user.
+func (c *User) DeleteUserSMS(in DeleteUserSMSInput) (out DeleteUserSMSOutput, err error) {
+	err = c.sendRequest("DELETE", fmt.Sprintf("/api/2.1/users/%d/sms/%d", in.UserID, in.TargetID), nil, &out)
+	return
+}
@@ -0,0 +1,956 @@
+// Copyright 2016 PayByPhone Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package users
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/paybyphone/pingdom-go-sdk/integration"
+	"github.com/paybyphone/pingdom-go-sdk/pingdom"
+)
+
+const errorResponseText = `
+{
+  "error": {
+    "statuscode": 403,
+    "statusdesc": "Forbidden",
+    "errormessage": "Something went wrong! This string describes what happened."
+  }
+}
+`
+
+const errorResponse = "Forbidden (403): Something went wrong! This string describes what happened."
+
+func newHTTPTestServer(f func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(f))
+	return ts
+}
+
+func httpErrorTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, errorResponseText, http.StatusForbidden)
+	})
+}
+
+func setPingdomenv() {
+	os.Setenv("PINGDOM_EMAIL_ADDRESS", "nobody@example.com")
+	os.Setenv("PINGDOM_PASSWORD", "changeit")
+	os.Setenv("PINGDOM_APP_KEY", "abcdefgh0123456789")
+	os.Unsetenv("PINGDOM_API_TOKEN")
+}
+
+func pingdomConfig() pingdom.Config {
+	return pingdom.Config{
+		EmailAddress: "overridden@example.com",
+		Password:     "overridden",
+		AppKey:       "overridden1234",
+	}
+}
+
+func userListEntryData() UserListEntry {
+	return UserListEntry{
+		ID:     1,
+		Name:   "John Doe",
+		Paused: "NO",
+		SMS: []UserSMSEntry{
+			UserSMSEntry{
+				ID:          11,
+				Severity:    "HIGH",
+				CountryCode: "1",
+				Number:      "5555555555",
+				Provider:    "nexmo",
+			},
+		},
+		Email: []UserEmailEntry{
+			UserEmailEntry{
+				ID:       12,
+				Severity: "LOW",
+				Address:  "john@johnsdomain.com",
+			},
+		},
+	}
+}
+
+const userListEntryText = `
+{
+	"id": 1,
+	"name": "John Doe",
+	"paused": "NO",
+	"sms": [{
+		"id": 11,
+		"severity": "HIGH",
+		"country_code": "1",
+		"number": "5555555555",
+		"provider": "nexmo"
+	}],
+	"email": [{
+		"id": 12,
+		"severity": "LOW",
+		"address": "john@johnsdomain.com"
+	}]
+}
+`
+
+func getUserListInputData() GetUserListInput {
+	return GetUserListInput{}
+}
+
+func getUserListOutputData() GetUserListOutput {
+	return GetUserListOutput{
+		Users: []UserListEntry{userListEntryData()},
+	}
+}
+
+const getUserListOutputText = `{"users": [` + userListEntryText + `]}`
+
+func httpGetUserListTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, getUserListOutputText, http.StatusOK)
+	})
+}
+
+func createUserInputData() CreateUserInput {
+	return CreateUserInput{
+		Name: "John Doe",
+	}
+}
+
+const createUserInputText = "name=John+Doe"
+
+func createUserOutputData() CreateUserOutput {
+	return CreateUserOutput{
+		User: CreateUserEntry{
+			ID: 1,
+		},
+	}
+}
+
+const createUserOutputText = `
+{
+	"user": {
+		"id": 1
+	}
+}
+`
+
+func httpCreateUserTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createUserOutputText, http.StatusOK)
+	})
+}
+
+func modifyUserInputData() ModifyUserInput {
+	return ModifyUserInput{
+		UserID:  1,
+		Name:    "John Doe",
+		Primary: "YES",
+		Paused:  "NO",
+	}
+}
+
+const modifyUserInputText = "name=John+Doe&paused=NO&primary=YES"
+
+func modifyUserOutputData() ModifyUserOutput {
+	return ModifyUserOutput{
+		Message: "Modification of user was successful!",
+	}
+}
+
+const modifyUserOutputText = `
+{
+	"message": "Modification of user was successful!"
+}
+`
+
+func httpModifyUserTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyUserOutputText, http.StatusOK)
+	})
+}
+
+func deleteUserInputData() DeleteUserInput {
+	return DeleteUserInput{
+		UserID: 1,
+	}
+}
+
+func deleteUserOutputData() DeleteUserOutput {
+	return DeleteUserOutput{
+		Message: "Deletion of user was successful!",
+	}
+}
+
+const deleteUserOutputText = `
+{
+	"message": "Deletion of user was successful!"
+}
+`
+
+func httpDeleteUserTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteUserOutputText, http.StatusOK)
+	})
+}
+
+func userEmailConfigurationData() UserEmailConfiguration {
+	return UserEmailConfiguration{
+		Address:       "john@johnsdomain.com",
+		SeverityLevel: "LOW",
+	}
+}
+
+const userEmailConfigurationText = "address=john%40johnsdomain.com&severitylevel=LOW"
+
+func createUserEmailInputData() CreateUserEmailInput {
+	return CreateUserEmailInput{
+		UserID:                 1,
+		UserEmailConfiguration: userEmailConfigurationData(),
+	}
+}
+
+func createUserEmailOutputData() CreateUserEmailOutput {
+	return CreateUserEmailOutput{
+		ContactTarget: ContactTargetEntry{
+			ID: 12,
+		},
+	}
+}
+
+const createUserTargetOutputText = `
+{
+	"contact_target": {
+		"id": 12
+	}
+}
+`
+
+func httpCreateUserEmailTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func modifyUserEmailInputData() ModifyUserEmailInput {
+	return ModifyUserEmailInput{
+		UserID:                 1,
+		TargetID:               12,
+		UserEmailConfiguration: userEmailConfigurationData(),
+	}
+}
+
+func modifyUserEmailOutputData() ModifyUserEmailOutput {
+	return ModifyUserEmailOutput{
+		Message: "Modification of contact target was successful!",
+	}
+}
+
+const modifyUserTargetOutputText = `
+{
+	"message": "Modification of contact target was successful!"
+}
+`
+
+func httpModifyUserEmailTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func deleteUserEmailInputData() DeleteUserEmailInput {
+	return DeleteUserEmailInput{
+		UserID:   1,
+		TargetID: 12,
+	}
+}
+
+func deleteUserEmailOutputData() DeleteUserEmailOutput {
+	return DeleteUserEmailOutput{
+		Message: "Deletion of contact target successful",
+	}
+}
+
+const deleteUserTargetOutputText = `
+{
+	"message": "Deletion of contact target successful"
+}
+`
+
+func httpDeleteUserEmailTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func userSMSConfigurationData() UserSMSConfiguration {
+	return UserSMSConfiguration{
+		Number:        "5555555555",
+		CountryCode:   "1",
+		Provider:      "nexmo",
+		SeverityLevel: "HIGH",
+	}
+}
+
+const userSMSConfigurationText = "countrycode=1&number=5555555555&provider=nexmo&severitylevel=HIGH"
+
+func createUserSMSInputData() CreateUserSMSInput {
+	return CreateUserSMSInput{
+		UserID:               1,
+		UserSMSConfiguration: userSMSConfigurationData(),
+	}
+}
+
+func createUserSMSOutputData() CreateUserSMSOutput {
+	return CreateUserSMSOutput{
+		ContactTarget: ContactTargetEntry{
+			ID: 12,
+		},
+	}
+}
+
+func httpCreateUserSMSTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, createUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func modifyUserSMSInputData() ModifyUserSMSInput {
+	return ModifyUserSMSInput{
+		UserID:               1,
+		TargetID:             12,
+		UserSMSConfiguration: userSMSConfigurationData(),
+	}
+}
+
+func modifyUserSMSOutputData() ModifyUserSMSOutput {
+	return ModifyUserSMSOutput{
+		Message: "Modification of contact target was successful!",
+	}
+}
+
+func httpModifyUserSMSTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, modifyUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func deleteUserSMSInputData() DeleteUserSMSInput {
+	return DeleteUserSMSInput{
+		UserID:   1,
+		TargetID: 12,
+	}
+}
+
+func deleteUserSMSOutputData() DeleteUserSMSOutput {
+	return DeleteUserSMSOutput{
+		Message: "Deletion of contact target successful",
+	}
+}
+
+func httpDeleteUserSMSTestServer() *httptest.Server {
+	return newHTTPTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		http.Error(w, deleteUserTargetOutputText, http.StatusOK)
+	})
+}
+
+func TestUserNewWithEnv(t *testing.T) {
+	setPingdomenv()
+	c := New()
+	if c.Config.Endpoint != "https://api.pingdom.com" {
+		t.Fatalf("Expected Endpoint to be https://api.pingdom.com, got %s", c.Config.Endpoint)
+	}
+	if c.Config.EmailAddress != "nobody@example.com" {
+		t.Fatalf("Expected EmailAddress to be nobody@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "changeit" {
+		t.Fatalf("Expected Password to be changeit, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "abcdefgh0123456789" {
+		t.Fatalf("Expected AppKey to be abcdefgh0123456789, got %s", c.Config.AppKey)
+	}
+}
+
+func TestUserNewWithOverride(t *testing.T) {
+	setPingdomenv()
+	c := New(pingdomConfig())
+	if c.Config.EmailAddress != "overridden@example.com" {
+		t.Fatalf("Expected EmailAddress to be overridden@example.com, got %s", c.Config.EmailAddress)
+	}
+	if c.Config.Password != "overridden" {
+		t.Fatalf("Expected Password to be overridden, got %s", c.Config.Password)
+	}
+	if c.Config.AppKey != "overridden1234" {
+		t.Fatalf("Expected AppKey to be overridden1234, got %s", c.Config.AppKey)
+	}
+}
+
+func TestGetUserList(t *testing.T) {
+	ts := httpGetUserListTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getUserListInputData()
+	out, err := c.GetUserList(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := getUserListOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestGetUserListError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := getUserListInputData()
+	_, err := c.GetUserList(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestCreateUserQueryText(t *testing.T) {
+	in := createUserInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := createUserInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	ts := httpCreateUserTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserInputData()
+	out, err := c.CreateUser(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createUserOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateUserError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserInputData()
+	_, err := c.CreateUser(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyUserQueryText(t *testing.T) {
+	in := modifyUserInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := modifyUserInputText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestModifyUser(t *testing.T) {
+	ts := httpModifyUserTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserInputData()
+	out, err := c.ModifyUser(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyUserOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyUserError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserInputData()
+	_, err := c.ModifyUser(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	ts := httpDeleteUserTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserInputData()
+	out, err := c.DeleteUser(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteUserOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteUserError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserInputData()
+	_, err := c.DeleteUser(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestUserEmailConfigurationQueryText(t *testing.T) {
+	in := createUserEmailInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := userEmailConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateUserEmail(t *testing.T) {
+	ts := httpCreateUserEmailTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserEmailInputData()
+	out, err := c.CreateUserEmail(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createUserEmailOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateUserEmailError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserEmailInputData()
+	_, err := c.CreateUserEmail(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyUserEmail(t *testing.T) {
+	ts := httpModifyUserEmailTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserEmailInputData()
+	out, err := c.ModifyUserEmail(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyUserEmailOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyUserEmailError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserEmailInputData()
+	_, err := c.ModifyUserEmail(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteUserEmail(t *testing.T) {
+	ts := httpDeleteUserEmailTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserEmailInputData()
+	out, err := c.DeleteUserEmail(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteUserEmailOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteUserEmailError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserEmailInputData()
+	_, err := c.DeleteUserEmail(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestUserSMSConfigurationQueryText(t *testing.T) {
+	in := createUserSMSInputData()
+	v, _ := query.Values(in)
+	out := v.Encode()
+	expected := userSMSConfigurationText
+
+	if out != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+}
+
+func TestCreateUserSMS(t *testing.T) {
+	ts := httpCreateUserSMSTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserSMSInputData()
+	out, err := c.CreateUserSMS(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := createUserSMSOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestCreateUserSMSError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := createUserSMSInputData()
+	_, err := c.CreateUserSMS(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestModifyUserSMS(t *testing.T) {
+	ts := httpModifyUserSMSTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserSMSInputData()
+	out, err := c.ModifyUserSMS(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := modifyUserSMSOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestModifyUserSMSError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := modifyUserSMSInputData()
+	_, err := c.ModifyUserSMS(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestDeleteUserSMS(t *testing.T) {
+	ts := httpDeleteUserSMSTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserSMSInputData()
+	out, err := c.DeleteUserSMS(in)
+
+	if err != nil {
+		t.Fatalf("Unexpected request error: %s", err)
+	}
+
+	expected := deleteUserSMSOutputData()
+
+	if reflect.DeepEqual(expected, out) == false {
+		t.Fatalf("expected %v, got %v", expected, out)
+	}
+}
+
+func TestDeleteUserSMSError(t *testing.T) {
+	ts := httpErrorTestServer()
+	defer ts.Close()
+	cfg := pingdomConfig()
+	cfg.Endpoint = ts.URL
+	c := New(cfg)
+	in := deleteUserSMSInputData()
+	_, err := c.DeleteUserSMS(in)
+
+	if err == nil {
+		t.Fatalf("Expected error, none found")
+	}
+
+	expected := errorResponse
+
+	if err.Error() != expected {
+		t.Fatalf("expected %s, got %s", expected, err)
+	}
+}
+
+func TestUsersTokenError(t *testing.T) {
+	cfg := pingdomConfig()
+	cfg.APIToken = "abcdefgh0123456789token"
+	c := New(cfg)
+	expected := "Users cannot be managed with an API token, use an email address, password, and application key instead"
+
+	if _, err := c.GetUserList(getUserListInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected GetUserList to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.CreateUser(createUserInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected CreateUser to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.CreateUserEmail(createUserEmailInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected CreateUserEmail to fail with %s, got %v", expected, err)
+	}
+	if _, err := c.DeleteUserSMS(deleteUserSMSInputData()); err == nil || err.Error() != expected {
+		t.Fatalf("expected DeleteUserSMS to fail with %s, got %v", expected, err)
+	}
+}
+
+// testAccUsersCRUDCreate runs the Create section of the CRUD test
+// (using CreateUser and CreateUserEmail).
+func testAccUsersCRUDCreate(t *testing.T, in CreateUserInput, email UserEmailConfiguration) int {
+	c := New()
+	out, err := c.CreateUser(in)
+	if err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	if out.User.ID == 0 {
+		t.Fatalf("Error reading user ID from output (out.User.ID was empty)")
+	}
+	_, err = c.CreateUserEmail(CreateUserEmailInput{UserID: out.User.ID, UserEmailConfiguration: email})
+	if err != nil {
+		t.Fatalf("Error creating email target: %v", err)
+	}
+	return out.User.ID
+}
+
+// testAccUsersCRUDRead runs the Read section of the CRUD test
+// (using GetUserList).
+func testAccUsersCRUDRead(t *testing.T, id int, name, address string) {
+	c := New()
+	out, err := c.GetUserList(GetUserListInput{})
+	if err != nil {
+		t.Fatalf("Error reading users: %v", err)
+	}
+	for _, v := range out.Users {
+		if v.ID != id {
+			continue
+		}
+		if v.Name != name {
+			t.Fatalf("Expected Name to be %s, got %v", name, v.Name)
+		}
+		if len(v.Email) != 1 || v.Email[0].Address != address {
+			t.Fatalf("Expected a single email target for %s, got %v", address, v.Email)
+		}
+		return
+	}
+	t.Fatalf("User %d not found in user list", id)
+}
+
+// testAccUsersCRUDUpdate runs the Update section of the CRUD test
+// (using ModifyUser).
+func testAccUsersCRUDUpdate(t *testing.T, id int, in ModifyUserInput, address string) {
+	c := New()
+	in.Name = "John Doe (updated)"
+	in.UserID = id
+	_, err := c.ModifyUser(in)
+	if err != nil {
+		t.Fatalf("Error updating user: %v", err)
+	}
+
+	testAccUsersCRUDRead(t, id, "John Doe (updated)", address)
+}
+
+// testAccUsersCRUDDelete runs the Delete section of the CRUD test
+// (using DeleteUser).
+func testAccUsersCRUDDelete(t *testing.T, id int) {
+	c := New()
+	_, err := c.DeleteUser(DeleteUserInput{UserID: id})
+	if err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+}
+
+// TestAccUsersCRUD runs a full create-read-update-delete test for a Pingdom
+// user with an email target.
+func TestAccUsersCRUD(t *testing.T) {
+	testacc.VetAccConditions(t)
+
+	create := CreateUserInput{Name: "John Doe"}
+	email := UserEmailConfiguration{Address: "john@example.com", SeverityLevel: "HIGH"}
+	update := ModifyUserInput{}
+
+	id := testAccUsersCRUDCreate(t, create, email)
+	testAccUsersCRUDRead(t, id, "John Doe", email.Address)
+	testAccUsersCRUDUpdate(t, id, update, email.Address)
+	testAccUsersCRUDDelete(t, id)
+}